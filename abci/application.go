@@ -0,0 +1,102 @@
+package abci
+
+import (
+	"context"
+	"time"
+)
+
+// CodeTypeOK is the result code of successful CheckTx and transaction execution.
+const CodeTypeOK uint32 = 0
+
+// CheckTxType distinguishes first validation of a transaction from revalidation after a block was committed.
+type CheckTxType int
+
+// CheckTx types.
+const (
+	CheckTxTypeNew CheckTxType = iota
+	CheckTxTypeRecheck
+)
+
+// Application is the subset of ABCI 2.0 application interface used by Executor.
+//
+// Methods and messages mirror their CometBFT counterparts (abci/types.Application), so existing ABCI
+// applications can be plugged in with a thin wrapper converting request and response types.
+type Application interface {
+	// Info returns height and app hash of the last committed block.
+	Info(ctx context.Context, req *InfoRequest) (*InfoResponse, error)
+	// InitChain is called once, when the chain is started from genesis.
+	InitChain(ctx context.Context, req *InitChainRequest) (*InitChainResponse, error)
+	// CheckTx validates transaction before it's added to the mempool.
+	CheckTx(ctx context.Context, req *CheckTxRequest) (*CheckTxResponse, error)
+	// FinalizeBlock executes all transactions of a block. State changes must not be persisted until Commit.
+	FinalizeBlock(ctx context.Context, req *FinalizeBlockRequest) (*FinalizeBlockResponse, error)
+	// Commit persists state changes of the last finalized block.
+	Commit(ctx context.Context, req *CommitRequest) (*CommitResponse, error)
+}
+
+// InfoRequest is the request of Application.Info.
+type InfoRequest struct{}
+
+// InfoResponse is the response of Application.Info.
+type InfoResponse struct {
+	LastBlockHeight  int64
+	LastBlockAppHash []byte
+}
+
+// InitChainRequest is the request of Application.InitChain.
+type InitChainRequest struct {
+	Time          time.Time
+	ChainID       string
+	InitialHeight int64
+	AppStateBytes []byte
+}
+
+// InitChainResponse is the response of Application.InitChain.
+type InitChainResponse struct {
+	AppHash []byte
+}
+
+// CheckTxRequest is the request of Application.CheckTx.
+type CheckTxRequest struct {
+	Tx   []byte
+	Type CheckTxType
+}
+
+// CheckTxResponse is the response of Application.CheckTx.
+type CheckTxResponse struct {
+	Code uint32
+	Log  string
+}
+
+// IsOK returns true if transaction is valid.
+func (r *CheckTxResponse) IsOK() bool {
+	return r.Code == CodeTypeOK
+}
+
+// FinalizeBlockRequest is the request of Application.FinalizeBlock.
+type FinalizeBlockRequest struct {
+	Txs    [][]byte
+	Height int64
+	Time   time.Time
+}
+
+// FinalizeBlockResponse is the response of Application.FinalizeBlock.
+type FinalizeBlockResponse struct {
+	TxResults []*ExecTxResult
+	AppHash   []byte
+}
+
+// ExecTxResult is the result of executing single transaction.
+type ExecTxResult struct {
+	Code uint32
+	Data []byte
+	Log  string
+}
+
+// CommitRequest is the request of Application.Commit.
+type CommitRequest struct{}
+
+// CommitResponse is the response of Application.Commit.
+type CommitResponse struct {
+	RetainHeight int64
+}
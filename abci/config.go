@@ -0,0 +1,26 @@
+package abci
+
+import "log/slog"
+
+// Config holds configuration settings for the ABCI executor.
+type Config struct {
+	// MaxBytes is the maximum allowed bytes for transactions in a block, reported by InitChain and ExecuteTxs.
+	MaxBytes uint64
+	// MaxPoolTxs is the maximum number of transactions in the mempool.
+	MaxPoolTxs int
+	// MaxPoolBytes is the maximum total size of transactions in the mempool.
+	MaxPoolBytes uint64
+	// AppStateBytes is the genesis application state passed to InitChain.
+	AppStateBytes []byte
+	// Logger is used to log failures of rechecking mempool transactions. Logging is disabled if Logger is nil.
+	Logger *slog.Logger
+}
+
+// DefaultConfig returns a Config instance populated with default settings.
+func DefaultConfig() *Config {
+	return &Config{
+		MaxBytes:     1024 * 1024,
+		MaxPoolTxs:   5000,
+		MaxPoolBytes: 64 * 1024 * 1024,
+	}
+}
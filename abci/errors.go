@@ -0,0 +1,16 @@
+package abci
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rollkit/go-execution/types"
+)
+
+var (
+	// ErrStateRootMismatch is returned when the previous state root doesn't match app hash of the last block.
+	// It wraps types.ErrStateRootMismatch.
+	ErrStateRootMismatch = fmt.Errorf("%w: previous state root doesn't match last app hash", types.ErrStateRootMismatch)
+	// ErrTxRejected is returned when the application rejects transaction in CheckTx
+	ErrTxRejected = errors.New("transaction rejected by application")
+)
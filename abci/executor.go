@@ -0,0 +1,254 @@
+// Package abci implements execution.Executor on top of an ABCI application.
+package abci
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/rollkit/go-execution"
+	"github.com/rollkit/go-execution/mempool"
+	"github.com/rollkit/go-execution/types"
+)
+
+// Executor implements execution.Executor by driving an ABCI application.
+//
+// InitChain is forwarded to the application, unless it already committed some blocks. ExecuteTxs calls
+// FinalizeBlock followed by Commit, so every executed block is immediately persisted by the application.
+// GetTxs returns transactions from the local mempool, which is fed by CheckTx and rechecked after every block.
+// SetFinal only keeps track of the finalized height, as ABCI has no notion of finality.
+type Executor struct {
+	app    Application
+	config *Config
+
+	// mu serializes all calls to the application
+	mu            sync.Mutex
+	initialized   bool
+	initialHeight uint64
+	height        uint64
+	appHash       types.Hash
+	finalized     uint64
+	retainHeight  uint64
+
+	mempool *mempool.Mempool
+}
+
+var (
+	_ execution.Executor      = (*Executor)(nil)
+	_ execution.HealthChecker = (*Executor)(nil)
+)
+
+// NewExecutor creates a new Executor for the given application.
+func NewExecutor(app Application, config *Config) *Executor {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	return &Executor{
+		app:    app,
+		config: config,
+		// pool limits are checked by CheckTx against config
+		mempool: mempool.New(&mempool.Config{Comparator: mempool.FIFO}),
+	}
+}
+
+// InitChain initializes the application with genesis parameters. If the application already committed
+// some blocks (e.g. after restart), InitChain is not called and app hash of the last block is returned.
+func (e *Executor) InitChain(ctx context.Context, genesisTime time.Time, initialHeight uint64, chainID string) (types.Hash, uint64, error) {
+	if initialHeight == 0 {
+		return types.Hash{}, 0, types.ErrZeroInitialHeight
+	}
+	if chainID == "" {
+		return types.Hash{}, 0, types.ErrEmptyChainID
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	info, err := e.app.Info(ctx, &InfoRequest{})
+	if err != nil {
+		return types.Hash{}, 0, fmt.Errorf("info: %w", err)
+	}
+	if info.LastBlockHeight > 0 {
+		e.setInitialized(initialHeight, uint64(info.LastBlockHeight), info.LastBlockAppHash) //nolint:gosec
+		return e.appHash, e.config.MaxBytes, nil
+	}
+
+	resp, err := e.app.InitChain(ctx, &InitChainRequest{
+		Time:          genesisTime,
+		ChainID:       chainID,
+		InitialHeight: int64(initialHeight), //nolint:gosec
+		AppStateBytes: e.config.AppStateBytes,
+	})
+	if err != nil {
+		return types.Hash{}, 0, fmt.Errorf("init chain: %w", err)
+	}
+	e.setInitialized(initialHeight, initialHeight-1, resp.AppHash)
+	return e.appHash, e.config.MaxBytes, nil
+}
+
+func (e *Executor) setInitialized(initialHeight, height uint64, appHash []byte) {
+	e.initialized = true
+	e.initialHeight = initialHeight
+	e.height = height
+	e.appHash = appHash
+}
+
+// CheckTx validates transaction with the application and adds it to the mempool.
+func (e *Executor) CheckTx(ctx context.Context, tx types.Tx) error {
+	if len(tx) == 0 {
+		return types.ErrEmptyTx
+	}
+	if uint64(len(tx)) > e.config.MaxBytes {
+		return types.ErrTxTooLarge
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.mempool.Has(tx) {
+		return types.ErrTxAlreadyExists
+	}
+	if e.mempool.Size() >= e.config.MaxPoolTxs || e.mempool.SizeBytes()+uint64(len(tx)) > e.config.MaxPoolBytes {
+		return types.ErrTxPoolFull
+	}
+	resp, err := e.app.CheckTx(ctx, &CheckTxRequest{Tx: tx, Type: CheckTxTypeNew})
+	if err != nil {
+		return fmt.Errorf("check tx: %w", err)
+	}
+	if !resp.IsOK() {
+		return fmt.Errorf("%w: code %d: %s", ErrTxRejected, resp.Code, resp.Log)
+	}
+	return e.mempool.Add(tx)
+}
+
+// GetTxs returns transactions from the mempool, in the order they were added.
+func (e *Executor) GetTxs(ctx context.Context) ([]types.Tx, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return e.mempool.Txs(), nil
+}
+
+// ExecuteTxs executes block with FinalizeBlock and persists it with Commit. Blocks must be executed
+// sequentially and prevStateRoot must match app hash of the last block.
+func (e *Executor) ExecuteTxs(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot types.Hash) (types.Hash, uint64, error) {
+	if blockHeight == 0 {
+		return types.Hash{}, 0, types.ErrInvalidBlockHeight
+	}
+	if len(prevStateRoot) == 0 {
+		return types.Hash{}, 0, types.ErrEmptyStateRoot
+	}
+	for _, tx := range txs {
+		if len(tx) == 0 {
+			return types.Hash{}, 0, types.ErrEmptyTx
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !e.initialized {
+		return types.Hash{}, 0, types.ErrChainNotInitialized
+	}
+	if blockHeight <= e.height {
+		return types.Hash{}, 0, fmt.Errorf("%w: height %d", types.ErrBlockAlreadyExists, blockHeight)
+	}
+	if blockHeight != e.height+1 {
+		return types.Hash{}, 0, fmt.Errorf("%w: expected height %d, got %d", types.ErrNonSequentialBlock, e.height+1, blockHeight)
+	}
+	if !bytes.Equal(prevStateRoot, e.appHash) {
+		return types.Hash{}, 0, fmt.Errorf("%w: last %x, given %x", ErrStateRootMismatch, e.appHash, prevStateRoot)
+	}
+
+	rawTxs := make([][]byte, len(txs))
+	for i, tx := range txs {
+		rawTxs[i] = tx
+	}
+	resp, err := e.app.FinalizeBlock(ctx, &FinalizeBlockRequest{
+		Txs:    rawTxs,
+		Height: int64(blockHeight), //nolint:gosec
+		Time:   timestamp,
+	})
+	if err != nil {
+		return types.Hash{}, 0, fmt.Errorf("finalize block: %w", err)
+	}
+	commit, err := e.app.Commit(ctx, &CommitRequest{})
+	if err != nil {
+		return types.Hash{}, 0, fmt.Errorf("commit: %w", err)
+	}
+
+	e.height = blockHeight
+	e.appHash = resp.AppHash
+	if commit.RetainHeight > 0 {
+		e.retainHeight = uint64(commit.RetainHeight)
+	}
+	e.mempool.Update(blockHeight, txs)
+	e.recheckTxs(ctx)
+
+	return e.appHash, e.config.MaxBytes, nil
+}
+
+// SetFinal marks block at given height as finalized. Finalized height never decreases.
+func (e *Executor) SetFinal(ctx context.Context, blockHeight uint64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !e.initialized || blockHeight < e.initialHeight || blockHeight > e.height {
+		return fmt.Errorf("%w: height %d", types.ErrBlockNotFound, blockHeight)
+	}
+	e.finalized = max(e.finalized, blockHeight)
+	return nil
+}
+
+// FinalizedHeight returns height of the last finalized block, or 0 if no block was finalized.
+func (e *Executor) FinalizedHeight() uint64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.finalized
+}
+
+// RetainHeight returns the lowest height the application asked to retain in the last Commit, or 0 if none.
+func (e *Executor) RetainHeight() uint64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.retainHeight
+}
+
+// CheckHealth reports executor as ready once the chain was initialized.
+func (e *Executor) CheckHealth(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !e.initialized {
+		return types.ErrChainNotInitialized
+	}
+	return nil
+}
+
+// recheckTxs revalidates remaining mempool transactions against the new state, dropping the ones rejected by
+// the application. If CheckTx fails, recheck stops and remaining transactions are kept; the error is logged,
+// as the block is already committed.
+func (e *Executor) recheckTxs(ctx context.Context) {
+	var err error
+	e.mempool.Filter(func(tx types.Tx) bool {
+		if err != nil {
+			return true
+		}
+		var resp *CheckTxResponse
+		resp, err = e.app.CheckTx(ctx, &CheckTxRequest{Tx: tx, Type: CheckTxTypeRecheck})
+		return err != nil || resp.IsOK()
+	})
+	if err != nil && e.config.Logger != nil {
+		e.config.Logger.Error("failed to recheck transactions", slog.Uint64("height", e.height), slog.Any("error", err))
+	}
+}
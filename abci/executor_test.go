@@ -0,0 +1,229 @@
+package abci_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-execution/abci"
+	"github.com/rollkit/go-execution/abci/kvstore"
+	"github.com/rollkit/go-execution/types"
+)
+
+func TestBlockProduction(t *testing.T) {
+	app := kvstore.NewApplication()
+	exec := abci.NewExecutor(app, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	assert.ErrorIs(t, exec.CheckHealth(ctx), types.ErrChainNotInitialized)
+
+	genesisTime := time.Now().UTC()
+	stateRoot, maxBytes, err := exec.InitChain(ctx, genesisTime, 1, "test-chain")
+	require.NoError(t, err)
+	assert.NotEmpty(t, stateRoot)
+	assert.Greater(t, maxBytes, uint64(0))
+	assert.NoError(t, exec.CheckHealth(ctx))
+
+	require.NoError(t, exec.CheckTx(ctx, types.Tx("a=1")))
+	require.NoError(t, exec.CheckTx(ctx, types.Tx("b=2")))
+	assert.ErrorIs(t, exec.CheckTx(ctx, types.Tx("a=1")), types.ErrTxAlreadyExists)
+	assert.ErrorIs(t, exec.CheckTx(ctx, types.Tx("invalid")), abci.ErrTxRejected)
+	assert.ErrorIs(t, exec.CheckTx(ctx, types.Tx{}), types.ErrEmptyTx)
+
+	for height := uint64(1); height <= 3; height++ {
+		txs, err := exec.GetTxs(ctx)
+		require.NoError(t, err)
+
+		prevStateRoot := stateRoot
+		blockTime := genesisTime.Add(time.Duration(height) * time.Second) //nolint:gosec
+		stateRoot, _, err = exec.ExecuteTxs(ctx, txs, height, blockTime, stateRoot)
+		require.NoError(t, err)
+		if len(txs) > 0 {
+			assert.NotEqual(t, prevStateRoot, stateRoot)
+		} else {
+			assert.Equal(t, prevStateRoot, stateRoot)
+		}
+
+		require.NoError(t, exec.SetFinal(ctx, height))
+		assert.Equal(t, height, exec.FinalizedHeight())
+
+		require.NoError(t, exec.CheckTx(ctx, types.Tx(fmt.Sprintf("h%d=%d", height, height))))
+	}
+
+	for key, value := range map[string]string{"a": "1", "b": "2", "h1": "1", "h2": "2"} {
+		got, ok := app.Get(key)
+		assert.True(t, ok)
+		assert.Equal(t, value, got)
+	}
+	txs, err := exec.GetTxs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Tx{types.Tx("h3=3")}, txs)
+
+	// executor created after restart continues on top of committed state
+	restarted := abci.NewExecutor(app, nil)
+	root, _, err := restarted.InitChain(ctx, genesisTime, 1, "test-chain")
+	require.NoError(t, err)
+	assert.Equal(t, stateRoot, root)
+	_, _, err = restarted.ExecuteTxs(ctx, nil, 4, genesisTime.Add(4*time.Second), root)
+	assert.NoError(t, err)
+}
+
+func TestExecuteTxsErrors(t *testing.T) {
+	exec := abci.NewExecutor(kvstore.NewApplication(), nil)
+	ctx := context.Background()
+
+	_, _, err := exec.ExecuteTxs(ctx, nil, 1, time.Now(), types.Hash{1})
+	assert.ErrorIs(t, err, types.ErrChainNotInitialized)
+
+	stateRoot, _, err := exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		txs           []types.Tx
+		blockHeight   uint64
+		prevStateRoot types.Hash
+		expectedErr   error
+	}{
+		{"zero height", nil, 0, stateRoot, types.ErrInvalidBlockHeight},
+		{"empty state root", nil, 1, types.Hash{}, types.ErrEmptyStateRoot},
+		{"empty transaction", []types.Tx{{}}, 1, stateRoot, types.ErrEmptyTx},
+		{"non-sequential height", nil, 3, stateRoot, types.ErrNonSequentialBlock},
+		{"state root mismatch", nil, 1, types.Hash("other root"), abci.ErrStateRootMismatch},
+		{"state root mismatch sentinel", nil, 1, types.Hash("other root"), types.ErrStateRootMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := exec.ExecuteTxs(ctx, tt.txs, tt.blockHeight, time.Now(), tt.prevStateRoot)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+
+	_, _, err = exec.ExecuteTxs(ctx, nil, 1, time.Now(), stateRoot)
+	require.NoError(t, err)
+	_, _, err = exec.ExecuteTxs(ctx, nil, 1, time.Now(), stateRoot)
+	assert.ErrorIs(t, err, types.ErrBlockAlreadyExists)
+}
+
+func TestSetFinal(t *testing.T) {
+	exec := abci.NewExecutor(kvstore.NewApplication(), nil)
+	ctx := context.Background()
+
+	assert.ErrorIs(t, exec.SetFinal(ctx, 1), types.ErrBlockNotFound)
+
+	stateRoot, _, err := exec.InitChain(ctx, time.Now().UTC(), 5, "test-chain")
+	require.NoError(t, err)
+	for height := uint64(5); height <= 7; height++ {
+		stateRoot, _, err = exec.ExecuteTxs(ctx, nil, height, time.Now(), stateRoot)
+		require.NoError(t, err)
+	}
+
+	assert.ErrorIs(t, exec.SetFinal(ctx, 4), types.ErrBlockNotFound)
+	assert.ErrorIs(t, exec.SetFinal(ctx, 8), types.ErrBlockNotFound)
+
+	require.NoError(t, exec.SetFinal(ctx, 6))
+	require.NoError(t, exec.SetFinal(ctx, 6))
+	assert.Equal(t, uint64(6), exec.FinalizedHeight())
+
+	// finalized height never decreases
+	require.NoError(t, exec.SetFinal(ctx, 5))
+	assert.Equal(t, uint64(6), exec.FinalizedHeight())
+}
+
+// recheckApp rejects all transactions during recheck.
+type recheckApp struct {
+	*kvstore.Application
+}
+
+func (a recheckApp) CheckTx(ctx context.Context, req *abci.CheckTxRequest) (*abci.CheckTxResponse, error) {
+	if req.Type == abci.CheckTxTypeRecheck {
+		return &abci.CheckTxResponse{Code: kvstore.CodeTypeInvalidTx, Log: "stale"}, nil
+	}
+	return a.Application.CheckTx(ctx, req)
+}
+
+func TestRecheck(t *testing.T) {
+	exec := abci.NewExecutor(recheckApp{kvstore.NewApplication()}, nil)
+	ctx := context.Background()
+
+	stateRoot, _, err := exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+	require.NoError(t, exec.CheckTx(ctx, types.Tx("a=1")))
+	require.NoError(t, exec.CheckTx(ctx, types.Tx("b=2")))
+
+	_, _, err = exec.ExecuteTxs(ctx, []types.Tx{types.Tx("a=1")}, 1, time.Now(), stateRoot)
+	require.NoError(t, err)
+
+	txs, err := exec.GetTxs(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, txs)
+
+	// dropped transaction can be submitted again
+	assert.NoError(t, exec.CheckTx(ctx, types.Tx("b=2")))
+}
+
+// failingRecheckApp fails every recheck, counting the calls.
+type failingRecheckApp struct {
+	*kvstore.Application
+	rechecks int
+}
+
+func (a *failingRecheckApp) CheckTx(ctx context.Context, req *abci.CheckTxRequest) (*abci.CheckTxResponse, error) {
+	if req.Type == abci.CheckTxTypeRecheck {
+		a.rechecks++
+		return nil, errors.New("connection lost")
+	}
+	return a.Application.CheckTx(ctx, req)
+}
+
+func TestRecheckError(t *testing.T) {
+	var log bytes.Buffer
+	app := &failingRecheckApp{Application: kvstore.NewApplication()}
+	config := abci.DefaultConfig()
+	config.Logger = slog.New(slog.NewTextHandler(&log, nil))
+	exec := abci.NewExecutor(app, config)
+	ctx := context.Background()
+
+	stateRoot, _, err := exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+	for _, tx := range []string{"a=1", "b=2", "c=3"} {
+		require.NoError(t, exec.CheckTx(ctx, types.Tx(tx)))
+	}
+
+	_, _, err = exec.ExecuteTxs(ctx, []types.Tx{types.Tx("a=1")}, 1, time.Now(), stateRoot)
+	require.NoError(t, err)
+
+	// recheck stops at the first error, keeping transactions
+	assert.Equal(t, 1, app.rechecks)
+	txs, err := exec.GetTxs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Tx{types.Tx("b=2"), types.Tx("c=3")}, txs)
+	assert.Contains(t, log.String(), "failed to recheck transactions")
+	assert.Contains(t, log.String(), "connection lost")
+}
+
+func TestPoolLimits(t *testing.T) {
+	config := abci.DefaultConfig()
+	config.MaxBytes = 8
+	config.MaxPoolTxs = 2
+	config.MaxPoolBytes = 10
+	exec := abci.NewExecutor(kvstore.NewApplication(), config)
+	ctx := context.Background()
+
+	assert.ErrorIs(t, exec.CheckTx(ctx, types.Tx("key=value")), types.ErrTxTooLarge)
+	require.NoError(t, exec.CheckTx(ctx, types.Tx("a=1")))
+	require.NoError(t, exec.CheckTx(ctx, types.Tx("b=2")))
+	assert.ErrorIs(t, exec.CheckTx(ctx, types.Tx("c=3")), types.ErrTxPoolFull)
+
+	config.MaxPoolTxs = 10
+	assert.ErrorIs(t, exec.CheckTx(ctx, types.Tx("cc=333")), types.ErrTxPoolFull)
+	assert.NoError(t, exec.CheckTx(ctx, types.Tx("c=3")))
+}
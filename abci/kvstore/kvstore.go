@@ -0,0 +1,140 @@
+// Package kvstore implements a minimal key-value store ABCI application, used to test abci.Executor.
+//
+// Transactions have the form "key=value". Every valid transaction sets the key to the value.
+package kvstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+	"sync"
+
+	"github.com/rollkit/go-execution/abci"
+)
+
+// CodeTypeInvalidTx is the result code of malformed transactions.
+const CodeTypeInvalidTx uint32 = 1
+
+// Application is a key-value store ABCI application. State is kept in memory.
+type Application struct {
+	mu        sync.RWMutex
+	state     map[string]string
+	staged    map[string]string
+	height    int64
+	appHash   []byte
+	stagedFor int64
+}
+
+var _ abci.Application = (*Application)(nil)
+
+// NewApplication creates a new, empty key-value store application.
+func NewApplication() *Application {
+	app := &Application{state: make(map[string]string)}
+	app.appHash = hashState(app.state)
+	return app
+}
+
+// Info returns height and app hash of the last committed block.
+func (a *Application) Info(context.Context, *abci.InfoRequest) (*abci.InfoResponse, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return &abci.InfoResponse{LastBlockHeight: a.height, LastBlockAppHash: a.appHash}, nil
+}
+
+// InitChain resets the store to the empty state.
+func (a *Application) InitChain(context.Context, *abci.InitChainRequest) (*abci.InitChainResponse, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.state = make(map[string]string)
+	a.staged = nil
+	a.appHash = hashState(a.state)
+	return &abci.InitChainResponse{AppHash: a.appHash}, nil
+}
+
+// CheckTx accepts transactions of the form "key=value" with non-empty key.
+func (a *Application) CheckTx(_ context.Context, req *abci.CheckTxRequest) (*abci.CheckTxResponse, error) {
+	if _, _, ok := parseTx(req.Tx); !ok {
+		return &abci.CheckTxResponse{Code: CodeTypeInvalidTx, Log: "expected key=value"}, nil
+	}
+	return &abci.CheckTxResponse{Code: abci.CodeTypeOK}, nil
+}
+
+// FinalizeBlock applies transactions of the block to a copy of the state. Malformed transactions are
+// included in the block, but have no effect.
+func (a *Application) FinalizeBlock(_ context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	staged := make(map[string]string, len(a.state)+len(req.Txs))
+	for k, v := range a.state {
+		staged[k] = v
+	}
+	results := make([]*abci.ExecTxResult, len(req.Txs))
+	for i, tx := range req.Txs {
+		key, value, ok := parseTx(tx)
+		if !ok {
+			results[i] = &abci.ExecTxResult{Code: CodeTypeInvalidTx, Log: "expected key=value"}
+			continue
+		}
+		staged[key] = value
+		results[i] = &abci.ExecTxResult{Code: abci.CodeTypeOK}
+	}
+	a.staged = staged
+	a.stagedFor = req.Height
+	return &abci.FinalizeBlockResponse{TxResults: results, AppHash: hashState(staged)}, nil
+}
+
+// Commit persists state of the last finalized block.
+func (a *Application) Commit(context.Context, *abci.CommitRequest) (*abci.CommitResponse, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.staged != nil {
+		a.state = a.staged
+		a.height = a.stagedFor
+		a.appHash = hashState(a.state)
+		a.staged = nil
+	}
+	return &abci.CommitResponse{}, nil
+}
+
+// Get returns committed value of the key.
+func (a *Application) Get(key string) (string, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	value, ok := a.state[key]
+	return value, ok
+}
+
+func parseTx(tx []byte) (string, string, bool) {
+	key, value, ok := bytes.Cut(tx, []byte("="))
+	if !ok || len(key) == 0 {
+		return "", "", false
+	}
+	return string(key), string(value), true
+}
+
+// hashState returns deterministic hash of all key-value pairs, ordered by key.
+func hashState(state map[string]string) []byte {
+	keys := make([]string, 0, len(state))
+	for k := range state {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	h := sha256.New()
+	var length [8]byte
+	for _, k := range keys {
+		for _, s := range []string{k, state[k]} {
+			binary.BigEndian.PutUint64(length[:], uint64(len(s)))
+			h.Write(length[:])
+			h.Write([]byte(s))
+		}
+	}
+	return h.Sum(nil)
+}
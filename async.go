@@ -0,0 +1,116 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rollkit/go-execution/types"
+)
+
+// AsyncExecutor is an optional interface that can be implemented by an Executor to execute blocks
+// asynchronously, so the caller can prepare the next block while the previous one is being executed.
+// Synchronous executors can be adapted with NewAsyncExecutor.
+type AsyncExecutor interface {
+	// ExecuteTxsAsync submits a block for execution and returns a future of its result.
+	// Requirements:
+	// - Must follow all requirements of ExecuteTxs
+	// - Must execute blocks in the order they were submitted, one at a time
+	// - Must execute the block on top of the state root of the previously submitted block if prevStateRoot is nil
+	// - Must fail the block without executing it if it's chained to a block that failed
+	// - Must fail the block with the context error if ctx is done before the block is executed
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control of the block execution
+	// - txs: Ordered list of transactions to execute
+	// - blockHeight: Height of the block being created (must be > 0)
+	// - timestamp: Block creation time in UTC
+	// - prevStateRoot: Previous block's state root hash, or nil to use result of the previously submitted block
+	//
+	// Returns:
+	// - *Future: Future resolved with results of ExecuteTxs
+	ExecuteTxsAsync(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot types.Hash) *Future
+}
+
+// Future is the result of asynchronously executed block.
+type Future struct {
+	done      chan struct{}
+	stateRoot types.Hash
+	maxBytes  uint64
+	err       error
+}
+
+// NewFuture creates a new unresolved Future.
+func NewFuture() *Future {
+	return &Future{done: make(chan struct{})}
+}
+
+// Resolve sets results of the block execution and wakes up all waiters. It must be called exactly once.
+func (f *Future) Resolve(stateRoot types.Hash, maxBytes uint64, err error) {
+	f.stateRoot = stateRoot
+	f.maxBytes = maxBytes
+	f.err = err
+	close(f.done)
+}
+
+// Done returns a channel that's closed when the future is resolved.
+func (f *Future) Done() <-chan struct{} {
+	return f.done
+}
+
+// Wait waits until the future is resolved or ctx is done, and returns results of the block execution.
+// Canceling ctx doesn't cancel the execution.
+func (f *Future) Wait(ctx context.Context) (types.Hash, uint64, error) {
+	select {
+	case <-f.done:
+		return f.stateRoot, f.maxBytes, f.err
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	}
+}
+
+// NewAsyncExecutor returns exec if it implements AsyncExecutor. Otherwise it returns an adapter executing
+// blocks with ExecuteTxs of exec in a background goroutine, one at a time.
+func NewAsyncExecutor(exec Executor) AsyncExecutor {
+	if async, ok := supports[AsyncExecutor](exec, CapabilityAsyncExecution); ok {
+		return async
+	}
+	return &asyncAdapter{exec: exec}
+}
+
+type asyncAdapter struct {
+	exec Executor
+
+	mu   sync.Mutex
+	last *Future
+}
+
+func (a *asyncAdapter) ExecuteTxsAsync(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot types.Hash) *Future {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	prev := a.last
+	future := NewFuture()
+	a.last = future
+
+	go func() {
+		if prev != nil {
+			// previous block is always resolved, so ordering doesn't depend on its context
+			<-prev.Done()
+			if prevStateRoot == nil {
+				if prev.err != nil {
+					future.Resolve(nil, 0, fmt.Errorf("previous block failed: %w", prev.err))
+					return
+				}
+				prevStateRoot = prev.stateRoot
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			future.Resolve(nil, 0, err)
+			return
+		}
+		future.Resolve(a.exec.ExecuteTxs(ctx, txs, blockHeight, timestamp, prevStateRoot))
+	}()
+	return future
+}
@@ -0,0 +1,92 @@
+package execution_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-execution"
+	"github.com/rollkit/go-execution/test"
+	"github.com/rollkit/go-execution/types"
+)
+
+// gatedExecutor records heights of executed blocks and executes them only after gate is closed.
+type gatedExecutor struct {
+	*test.DummyExecutor
+	gate chan struct{}
+
+	mu      sync.Mutex
+	heights []uint64
+}
+
+func (e *gatedExecutor) ExecuteTxs(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot types.Hash) (types.Hash, uint64, error) {
+	<-e.gate
+	e.mu.Lock()
+	e.heights = append(e.heights, blockHeight)
+	e.mu.Unlock()
+	return e.DummyExecutor.ExecuteTxs(ctx, txs, blockHeight, timestamp, prevStateRoot)
+}
+
+func TestAsyncAdapter(t *testing.T) {
+	exec := &gatedExecutor{DummyExecutor: test.NewDummyExecutor(), gate: make(chan struct{})}
+	async := execution.NewAsyncExecutor(struct{ execution.Executor }{exec})
+	ctx := context.Background()
+	txs := []types.Tx{types.Tx("tx")}
+	timestamp := time.Now()
+
+	futures := []*execution.Future{
+		async.ExecuteTxsAsync(ctx, txs, 1, timestamp, types.Hash{1}),
+		async.ExecuteTxsAsync(ctx, txs, 2, timestamp, nil),
+		async.ExecuteTxsAsync(ctx, txs, 3, timestamp, nil),
+	}
+	select {
+	case <-futures[0].Done():
+		t.Fatal("block executed before the gate was opened")
+	default:
+	}
+	close(exec.gate)
+
+	// blocks are executed in order, chained to state roots of previous blocks
+	expected := types.Hash{1}
+	for i, future := range futures {
+		stateRoot, _, err := future.Wait(ctx)
+		require.NoError(t, err)
+		expected, _, err = test.NewDummyExecutor().ExecuteTxs(ctx, txs, uint64(i+1), timestamp, expected)
+		require.NoError(t, err)
+		assert.Equal(t, expected, stateRoot)
+	}
+	assert.Equal(t, []uint64{1, 2, 3}, exec.heights)
+}
+
+func TestAsyncAdapterErrors(t *testing.T) {
+	exec := &gatedExecutor{DummyExecutor: test.NewDummyExecutor(), gate: make(chan struct{})}
+	async := execution.NewAsyncExecutor(exec)
+	ctx := context.Background()
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	failed := async.ExecuteTxsAsync(ctx, []types.Tx{nil}, 1, time.Now(), types.Hash{1})
+	chained := async.ExecuteTxsAsync(ctx, nil, 2, time.Now(), nil)
+	unchained := async.ExecuteTxsAsync(ctx, nil, 2, time.Now(), types.Hash{1})
+	skipped := async.ExecuteTxsAsync(canceled, nil, 3, time.Now(), types.Hash{1})
+	close(exec.gate)
+
+	_, _, err := failed.Wait(ctx)
+	assert.ErrorIs(t, err, types.ErrEmptyTx)
+	_, _, err = chained.Wait(ctx)
+	assert.ErrorIs(t, err, types.ErrEmptyTx)
+	_, _, err = unchained.Wait(ctx)
+	assert.NoError(t, err)
+	_, _, err = skipped.Wait(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []uint64{1, 2}, exec.heights)
+
+	// waiting can be canceled independently of the execution
+	pending := execution.NewFuture()
+	_, _, err = pending.Wait(canceled)
+	assert.ErrorIs(t, err, context.Canceled)
+}
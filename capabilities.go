@@ -0,0 +1,88 @@
+package execution
+
+import "slices"
+
+// Describer is an optional interface that can be implemented by an Executor to report its name and version,
+// e.g. to clients of proxies.
+type Describer interface {
+	// Describe returns name and version of the executor.
+	Describe() (name, version string)
+}
+
+// Names of capabilities reported by Capabilities, one for every optional interface.
+const (
+	CapabilityHealthCheck      = "health_check"
+	CapabilityLimitedTxs       = "limited_txs"
+	CapabilityPaginatedTxs     = "paginated_txs"
+	CapabilityTxInfo           = "tx_info"
+	CapabilitySimulation       = "simulation"
+	CapabilityBatchExecution   = "batch_execution"
+	CapabilityVerification     = "verification"
+	CapabilityAsyncExecution   = "async_execution"
+	CapabilitySnapshotProvider = "snapshot_provider"
+	CapabilitySnapshotRestorer = "snapshot_restorer"
+	CapabilityPruning          = "pruning"
+	CapabilityStateQueries     = "state_queries"
+	CapabilityRangeFinality    = "range_finality"
+)
+
+// CapabilityReporter is an optional interface that can be implemented by an Executor whose optional interfaces
+// don't tell which capabilities are actually supported, e.g. a proxy client implementing all of them. Helpers
+// like VerifyBlock use their fallback if exec reports that it doesn't support the capability.
+type CapabilityReporter interface {
+	// Capabilities returns names of supported capabilities, or false if they are not known.
+	Capabilities() ([]string, bool)
+}
+
+// Capabilities returns names of optional interfaces implemented by exec, in the order of the constants.
+// Capabilities reported by exec implementing CapabilityReporter take precedence, if they are known.
+func Capabilities(exec Executor) []string {
+	if reporter, ok := exec.(CapabilityReporter); ok {
+		if capabilities, known := reporter.Capabilities(); known {
+			return capabilities
+		}
+	}
+	var capabilities []string
+	for _, c := range []struct {
+		name string
+		ok   bool
+	}{
+		{CapabilityHealthCheck, implements[HealthChecker](exec)},
+		{CapabilityLimitedTxs, implements[LimitedTxGetter](exec)},
+		{CapabilityPaginatedTxs, implements[PaginatedTxGetter](exec)},
+		{CapabilityTxInfo, implements[TxInfoGetter](exec)},
+		{CapabilitySimulation, implements[Simulator](exec)},
+		{CapabilityBatchExecution, implements[BatchExecutor](exec)},
+		{CapabilityVerification, implements[Verifier](exec)},
+		{CapabilityAsyncExecution, implements[AsyncExecutor](exec)},
+		{CapabilitySnapshotProvider, implements[SnapshotProvider](exec)},
+		{CapabilitySnapshotRestorer, implements[SnapshotRestorer](exec)},
+		{CapabilityPruning, implements[Pruner](exec)},
+		{CapabilityStateQueries, implements[StateQuerier](exec)},
+		{CapabilityRangeFinality, implements[RangeFinalizer](exec)},
+	} {
+		if c.ok {
+			capabilities = append(capabilities, c.name)
+		}
+	}
+	return capabilities
+}
+
+// supports returns exec as T if it implements T, unless it reports that it doesn't support the capability.
+func supports[T any](exec Executor, capability string) (T, bool) {
+	impl, ok := exec.(T)
+	if !ok {
+		return impl, false
+	}
+	if reporter, ok := exec.(CapabilityReporter); ok {
+		if capabilities, known := reporter.Capabilities(); known && !slices.Contains(capabilities, capability) {
+			return impl, false
+		}
+	}
+	return impl, true
+}
+
+func implements[T any](exec Executor) bool {
+	_, ok := exec.(T)
+	return ok
+}
@@ -1,78 +1,114 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
 
-	"google.golang.org/grpc"
-
+	"github.com/rollkit/go-execution/mempool"
 	grpcproxy "github.com/rollkit/go-execution/proxy/grpc"
+	"github.com/rollkit/go-execution/proxy/stdio"
 	"github.com/rollkit/go-execution/test"
-	pb "github.com/rollkit/go-execution/types/pb/execution"
 )
 
 func main() {
-	listenAddress, err := parseListenAddress()
-	if err != nil {
-		log.Fatalf("Failed to parse listen address: %v\n", err)
-	}
-	listener, err := net.Listen("tcp4", listenAddress)
+	config := grpcproxy.DefaultConfig()
+	mempoolConfig := mempool.DefaultConfig()
+	var serveStdio bool
+	logLevel, err := parseFlags(config, mempoolConfig, &serveStdio)
 	if err != nil {
-		log.Fatalf("Failed to listen on %q: %v\n", listenAddress, err)
+		fatal("Failed to parse flags", err)
 	}
-	defer func() {
-		_ = listener.Close()
-	}()
 
-	log.Println("Creating Dummy Executor and gRPC server")
-	dummy := test.NewDummyExecutor()
-	server := grpcproxy.NewServer(dummy, grpcproxy.DefaultConfig())
-	s := grpc.NewServer()
-	pb.RegisterExecutionServiceServer(s, server)
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: logLevel}))
+	slog.SetDefault(logger)
+	config.Logger = logger
 
 	// Setup signal handling
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
-	doneChan := make(chan interface{}, 1)
-	go func() {
-		log.Printf("Serving (%s)...\n", listenAddress)
-		log.Println("Type Ctrl+C to shutdown")
-		if err := s.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
-			log.Fatalf("Server exited with error: %v\n", err)
+	mp, err := mempool.Open(mempoolConfig)
+	if err != nil {
+		fatal("Failed to open mempool", err, "wal", mempoolConfig.WALPath)
+	}
+	defer func() {
+		if err := mp.Close(); err != nil {
+			slog.Error("Failed to close mempool", "error", err)
 		}
-		doneChan <- nil
 	}()
+	dummy := test.NewDummyExecutorWithMempool(mp)
+	if serveStdio {
+		slog.Info("Serving Dummy Executor over stdio")
+		if err := stdio.ServeStdio(ctx, dummy); err != nil && !errors.Is(err, context.Canceled) {
+			fatal("Failed to serve over stdio", err)
+		}
+		return
+	}
 
-	// Handle shutdown signal
-	go func() {
-		<-sigChan
-		log.Println("Received shutdown signal")
-		s.GracefulStop()
-	}()
+	slog.Info("Creating Dummy Executor and gRPC server")
+	server, err := grpcproxy.StartServer(ctx, dummy, config)
+	if err != nil {
+		fatal("Failed to start server", err, "address", config.ListenAddress)
+	}
+
+	slog.Info("Serving...", "address", server.Addr())
+	slog.Info("Type Ctrl+C to shutdown")
+
+	select {
+	case <-ctx.Done():
+		slog.Info("Received shutdown signal")
+	case <-server.Done():
+	}
+	if err := server.Stop(); err != nil {
+		fatal("Server exited with error", err)
+	}
+	slog.Info("Server stopped")
+}
 
-	<-doneChan
-	log.Println("Server stopped")
+func fatal(msg string, err error, args ...any) {
+	slog.Error(msg, append(args, "error", err)...)
+	os.Exit(1)
 }
 
-func parseListenAddress() (string, error) {
-	var listenAddress string
-	flag.StringVar(&listenAddress, "address", "127.0.0.1:40041", "gRPC server listen address")
+func parseFlags(config *grpcproxy.Config, mempoolConfig *mempool.Config, serveStdio *bool) (slog.Level, error) {
+	var logLevel string
+	flag.StringVar(&config.ListenAddress, "address", config.ListenAddress, "gRPC server listen address (host:port or unix:///path/to/socket)")
+	flag.StringVar(&logLevel, "log-level", "info", "log level (debug, info, warn, error)")
+	flag.BoolVar(&config.EnableReflection, "reflection", false, "enable gRPC server reflection")
+	flag.StringVar(&config.TLSCertFile, "tls-cert", "", "path to PEM encoded TLS certificate")
+	flag.StringVar(&config.TLSKeyFile, "tls-key", "", "path to PEM encoded TLS private key")
+	flag.StringVar(&config.TLSCAFile, "tls-ca", "", "path to PEM encoded CA certificates used to verify clients (enables mutual TLS)")
+	flag.DurationVar(&config.ShutdownTimeout, "shutdown-timeout", config.ShutdownTimeout, "time given to pending calls during graceful shutdown")
+	flag.StringVar(&mempoolConfig.WALPath, "mempool-wal", "", "path of mempool write-ahead log, which keeps pending transactions across restarts")
+	flag.BoolVar(serveStdio, "stdio", false, "serve over standard input and output instead of gRPC (for use as child process)")
 	flag.Parse()
 
-	_, port, err := net.SplitHostPort(listenAddress)
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(logLevel)); err != nil {
+		return 0, fmt.Errorf("invalid log level %q: %w", logLevel, err)
+	}
+
+	if *serveStdio {
+		return level, nil
+	}
+	if _, ok := grpcproxy.UnixSocketPath(config.ListenAddress); ok {
+		return level, nil
+	}
+
+	_, port, err := net.SplitHostPort(config.ListenAddress)
 	if err != nil {
-		return "", fmt.Errorf("invalid address format %q: %v", listenAddress, err)
+		return 0, fmt.Errorf("invalid address format %q: %v", config.ListenAddress, err)
 	}
 	if port == "" {
-		return "", errors.New("port cannot be empty")
+		return 0, errors.New("port cannot be empty")
 	}
 
-	return listenAddress, nil
+	return level, nil
 }
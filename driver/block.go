@@ -0,0 +1,24 @@
+package driver
+
+import (
+	"context"
+	"time"
+
+	"github.com/rollkit/go-execution/types"
+)
+
+// Block is a block produced by the sequencer.
+type Block struct {
+	Height uint64
+	Time   time.Time
+	Txs    []types.Tx
+	// StateRoot is the state root after execution of the block.
+	StateRoot types.Hash
+}
+
+// BlockSource provides blocks produced by the sequencer, e.g. fetched from data availability layer or peers.
+type BlockSource interface {
+	// GetBlock returns block at given height. It returns error wrapping types.ErrBlockNotFound if the block is
+	// not available yet.
+	GetBlock(ctx context.Context, height uint64) (*Block, error)
+}
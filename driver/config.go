@@ -0,0 +1,35 @@
+package driver
+
+import (
+	"log/slog"
+	"time"
+)
+
+// Config holds configuration settings for the Driver.
+type Config struct {
+	// ChainID, GenesisTime and InitialHeight are genesis parameters passed to InitChain.
+	ChainID       string
+	GenesisTime   time.Time
+	InitialHeight uint64
+
+	// SyncInterval is the interval of polling block source for new blocks in Sync.
+	SyncInterval time.Duration
+
+	// PruneKeepRecent is the number of most recent heights whose state is kept when the executor implements
+	// execution.Pruner. Pruning is disabled if PruneKeepRecent is 0.
+	PruneKeepRecent uint64
+	// PruneKeepEvery, if set, keeps state of every height that is its multiple, also when it's older than
+	// PruneKeepRecent heights.
+	PruneKeepEvery uint64
+
+	// Logger is used to log produced and applied blocks, and pruning failures. Logging is disabled if Logger is nil.
+	Logger *slog.Logger
+}
+
+// DefaultConfig returns a Config instance populated with default settings.
+func DefaultConfig() *Config {
+	return &Config{
+		InitialHeight: 1,
+		SyncInterval:  time.Second,
+	}
+}
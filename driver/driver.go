@@ -0,0 +1,282 @@
+// Package driver drives an execution.Executor through the block lifecycle, either producing blocks as a
+// sequencer or replaying and verifying blocks of the sequencer as a full node.
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rollkit/go-execution"
+	"github.com/rollkit/go-execution/types"
+)
+
+// Driver calls Execution API methods in the order expected by executors. Every block is finalized right after
+// it's executed. Driver is not safe for concurrent use.
+type Driver struct {
+	exec   execution.Executor
+	config *Config
+
+	initialized bool
+	height      uint64
+	stateRoot   types.Hash
+	maxBytes    uint64
+	retained    uint64
+	// noPruning is set when the executor turns out not to support pruning
+	noPruning bool
+}
+
+// NewDriver creates a new Driver of given executor.
+func NewDriver(exec execution.Executor, config *Config) *Driver {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	return &Driver{
+		exec:   exec,
+		config: config,
+	}
+}
+
+// InitChain initializes the chain with genesis parameters from config.
+func (d *Driver) InitChain(ctx context.Context) error {
+	stateRoot, maxBytes, err := d.exec.InitChain(ctx, d.config.GenesisTime, d.config.InitialHeight, d.config.ChainID)
+	if err != nil {
+		return fmt.Errorf("init chain: %w", err)
+	}
+	d.initialized = true
+	d.height = d.config.InitialHeight - 1
+	d.stateRoot = stateRoot
+	d.maxBytes = maxBytes
+	// there is no state below the initial height
+	d.retained = d.config.InitialHeight
+	return nil
+}
+
+// Height returns height of the last block, or initial height - 1 if no block was executed.
+func (d *Driver) Height() uint64 {
+	return d.height
+}
+
+// StateRoot returns state root after the last block.
+func (d *Driver) StateRoot() types.Hash {
+	return d.stateRoot
+}
+
+// ProduceBlock produces the next block with transactions from the mempool fitting into the block, as a sequencer.
+func (d *Driver) ProduceBlock(ctx context.Context, timestamp time.Time) (*Block, error) {
+	if !d.initialized {
+		return nil, types.ErrChainNotInitialized
+	}
+
+	txs, err := d.nextTxs(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	block := &Block{
+		Height: d.height + 1,
+		Time:   timestamp,
+		Txs:    txs,
+	}
+	stateRoot, maxBytes, err := d.exec.ExecuteTxs(ctx, txs, block.Height, timestamp, d.stateRoot)
+	if err != nil {
+		return nil, fmt.Errorf("execute block %d: %w", block.Height, err)
+	}
+	block.StateRoot = stateRoot
+	if err := d.finalize(ctx, block, maxBytes); err != nil {
+		return nil, err
+	}
+	d.log("produced block", block)
+	return block, nil
+}
+
+// ProduceBlocks produces a block for every timestamp, as a sequencer. Blocks are submitted for execution without
+// waiting for the previous ones (see execution.AsyncExecutor): the first block is chained to the state root of
+// the last block, and every other block to the result of the block submitted before it. Transactions of a block
+// are fetched from the mempool while previous blocks are executed, excluding transactions of the submitted
+// blocks. Executed blocks are finalized in order. Blocks produced before an error are returned with the error.
+func (d *Driver) ProduceBlocks(ctx context.Context, timestamps []time.Time) ([]*Block, error) {
+	if !d.initialized {
+		return nil, types.ErrChainNotInitialized
+	}
+
+	async := execution.NewAsyncExecutor(d.exec)
+	submitted := make([]*Block, 0, len(timestamps))
+	futures := make([]*execution.Future, 0, len(timestamps))
+	var submittedTxs []types.Tx
+	var fetchErr error
+	for i, timestamp := range timestamps {
+		txs, err := d.nextTxs(ctx, submittedTxs)
+		if err != nil {
+			fetchErr = err
+			break
+		}
+		block := &Block{
+			Height: d.height + 1 + uint64(i), //nolint:gosec
+			Time:   timestamp,
+			Txs:    txs,
+		}
+		prevStateRoot := d.stateRoot
+		if i > 0 {
+			// chained to the result of the previous block
+			prevStateRoot = nil
+		}
+		futures = append(futures, async.ExecuteTxsAsync(ctx, txs, block.Height, timestamp, prevStateRoot))
+		submitted = append(submitted, block)
+		submittedTxs = append(submittedTxs, txs...)
+	}
+
+	blocks := make([]*Block, 0, len(submitted))
+	for i, block := range submitted {
+		stateRoot, maxBytes, err := futures[i].Wait(ctx)
+		if err != nil {
+			return blocks, fmt.Errorf("execute block %d: %w", block.Height, err)
+		}
+		block.StateRoot = stateRoot
+		if err := d.finalize(ctx, block, maxBytes); err != nil {
+			return blocks, err
+		}
+		d.log("produced block", block)
+		blocks = append(blocks, block)
+	}
+	return blocks, fetchErr
+}
+
+// nextTxs returns transactions from the mempool fitting into the next block, except for transactions of the
+// blocks being executed, which may still be in the mempool.
+func (d *Driver) nextTxs(ctx context.Context, executing []types.Tx) ([]types.Tx, error) {
+	limits := types.TxLimits{MaxBytes: d.maxBytes}
+	if limits.MaxBytes > 0 {
+		for _, tx := range executing {
+			limits.MaxBytes += uint64(len(tx))
+		}
+	}
+	txs, err := execution.GetTxsWithLimits(ctx, d.exec, limits)
+	if err != nil {
+		return nil, fmt.Errorf("get txs: %w", err)
+	}
+	if len(executing) == 0 {
+		return txs, nil
+	}
+
+	skip := make(map[string]struct{}, len(executing))
+	for _, tx := range executing {
+		skip[string(tx)] = struct{}{}
+	}
+	filtered := make([]types.Tx, 0, len(txs))
+	for _, tx := range txs {
+		if _, ok := skip[string(tx)]; !ok {
+			filtered = append(filtered, tx)
+		}
+	}
+	return types.TxLimits{MaxBytes: d.maxBytes}.Trim(filtered), nil
+}
+
+// ApplyBlock verifies the next block of the sequencer, as a full node. It returns error wrapping
+// *types.StateRootMismatchError if the block doesn't produce the state root of the sequencer.
+func (d *Driver) ApplyBlock(ctx context.Context, block *Block) error {
+	if !d.initialized {
+		return types.ErrChainNotInitialized
+	}
+	if block.Height != d.height+1 {
+		return fmt.Errorf("%w: expected height %d, got %d", types.ErrNonSequentialBlock, d.height+1, block.Height)
+	}
+
+	err := execution.VerifyBlock(ctx, d.exec, block.Txs, block.Height, block.Time, d.stateRoot, block.StateRoot)
+	if err != nil {
+		return fmt.Errorf("verify block %d: %w", block.Height, err)
+	}
+	if err := d.finalize(ctx, block, d.maxBytes); err != nil {
+		return err
+	}
+	d.log("applied block", block)
+	return nil
+}
+
+// Sync applies blocks from source until the context is done or applying a block fails. Source is polled every
+// Config.SyncInterval when the next block is not available.
+func (d *Driver) Sync(ctx context.Context, source BlockSource) error {
+	for {
+		block, err := source.GetBlock(ctx, d.height+1)
+		switch {
+		case errors.Is(err, types.ErrBlockNotFound):
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(d.config.SyncInterval):
+			}
+			continue
+		case err != nil:
+			return fmt.Errorf("get block %d: %w", d.height+1, err)
+		}
+		if err := d.ApplyBlock(ctx, block); err != nil {
+			return err
+		}
+	}
+}
+
+func (d *Driver) finalize(ctx context.Context, block *Block, maxBytes uint64) error {
+	if err := d.exec.SetFinal(ctx, block.Height); err != nil {
+		return fmt.Errorf("set final %d: %w", block.Height, err)
+	}
+	d.height = block.Height
+	d.stateRoot = block.StateRoot
+	d.maxBytes = maxBytes
+	d.prune(ctx)
+	return nil
+}
+
+// RetainHeight returns the lowest height whose state is kept according to the pruning policy from config,
+// or 0 if no state is pruned. State of heights below it is kept only at multiples of Config.PruneKeepEvery.
+func (d *Driver) RetainHeight() uint64 {
+	keepRecent := d.config.PruneKeepRecent
+	if keepRecent == 0 || d.height < keepRecent {
+		return 0
+	}
+	return d.height - keepRecent + 1
+}
+
+// prune prunes the executor according to the pruning policy. Failures are only logged, as the state is
+// pruned again after the next block. Executors not supporting pruning, including those failing with
+// Unimplemented error, are not pruned again.
+func (d *Driver) prune(ctx context.Context) {
+	if d.noPruning {
+		return
+	}
+	retainHeight := d.RetainHeight()
+	if retainHeight <= d.retained {
+		return
+	}
+	pruner, ok := d.exec.(execution.Pruner)
+	if !ok || !slices.Contains(execution.Capabilities(d.exec), execution.CapabilityPruning) {
+		d.noPruning = true
+		return
+	}
+	err := pruner.Prune(ctx, retainHeight, d.config.PruneKeepEvery)
+	if status.Code(err) == codes.Unimplemented {
+		d.noPruning = true
+		if d.config.Logger != nil {
+			d.config.Logger.Warn("executor doesn't support pruning", slog.Any("error", err))
+		}
+		return
+	}
+	if err != nil {
+		if d.config.Logger != nil {
+			d.config.Logger.Error("failed to prune", slog.Uint64("retain_height", retainHeight), slog.Any("error", err))
+		}
+		return
+	}
+	d.retained = retainHeight
+}
+
+func (d *Driver) log(msg string, block *Block) {
+	if d.config.Logger != nil {
+		d.config.Logger.Info(msg, slog.Uint64("height", block.Height), slog.Int("tx_count", len(block.Txs)),
+			slog.String("state_root", fmt.Sprintf("%X", block.StateRoot)))
+	}
+}
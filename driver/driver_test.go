@@ -0,0 +1,307 @@
+package driver_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rollkit/go-execution"
+	"github.com/rollkit/go-execution/driver"
+	"github.com/rollkit/go-execution/test"
+	"github.com/rollkit/go-execution/types"
+)
+
+// blockStore is an in-memory BlockSource, calling onMissing when requested block is not available.
+type blockStore struct {
+	mu        sync.Mutex
+	blocks    map[uint64]*driver.Block
+	onMissing func()
+}
+
+func (s *blockStore) GetBlock(_ context.Context, height uint64) (*driver.Block, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	block, ok := s.blocks[height]
+	if !ok {
+		if s.onMissing != nil {
+			s.onMissing()
+		}
+		return nil, fmt.Errorf("%w: height %d", types.ErrBlockNotFound, height)
+	}
+	return block, nil
+}
+
+func testConfig() *driver.Config {
+	config := driver.DefaultConfig()
+	config.ChainID = "test-chain"
+	config.GenesisTime = time.Now().UTC().Add(-time.Hour)
+	config.SyncInterval = time.Millisecond
+	return config
+}
+
+// produceBlocks produces blocks with the sequencer driver, with a transaction in every block.
+func produceBlocks(t *testing.T, n int) *blockStore {
+	t.Helper()
+	exec := test.NewDummyExecutor()
+	sequencer := driver.NewDriver(exec, testConfig())
+	ctx := context.Background()
+	require.NoError(t, sequencer.InitChain(ctx))
+
+	store := &blockStore{blocks: make(map[uint64]*driver.Block)}
+	for i := 0; i < n; i++ {
+		exec.InjectTx(types.Tx(fmt.Sprintf("tx-%d", i)))
+		block, err := sequencer.ProduceBlock(ctx, time.Now())
+		require.NoError(t, err)
+		require.Len(t, block.Txs, 1)
+		store.blocks[block.Height] = block
+	}
+	assert.Equal(t, uint64(n), sequencer.Height())
+	return store
+}
+
+func TestSync(t *testing.T) {
+	for name, exec := range map[string]execution.Executor{
+		"Verifier": test.NewDummyExecutor(),
+		// executor without Verifier
+		"fallback": struct{ execution.Executor }{test.NewDummyExecutor()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			store := produceBlocks(t, 5)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			store.onMissing = cancel
+
+			fullNode := driver.NewDriver(exec, testConfig())
+			require.NoError(t, fullNode.InitChain(ctx))
+			err := fullNode.Sync(ctx, store)
+			assert.ErrorIs(t, err, context.Canceled)
+			assert.Equal(t, uint64(5), fullNode.Height())
+			assert.Equal(t, store.blocks[5].StateRoot, fullNode.StateRoot())
+		})
+	}
+}
+
+func TestSyncStateRootMismatch(t *testing.T) {
+	store := produceBlocks(t, 3)
+	store.blocks[2].StateRoot = types.Hash("invalid")
+
+	fullNode := driver.NewDriver(test.NewDummyExecutor(), testConfig())
+	ctx := context.Background()
+	require.NoError(t, fullNode.InitChain(ctx))
+
+	err := fullNode.Sync(ctx, store)
+	require.ErrorIs(t, err, types.ErrStateRootMismatch)
+	var mismatch *types.StateRootMismatchError
+	require.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, uint64(2), mismatch.Height)
+	assert.Equal(t, types.Hash("invalid"), mismatch.Expected)
+	assert.NotEqual(t, mismatch.Expected, mismatch.Actual)
+	assert.Equal(t, uint64(1), fullNode.Height())
+}
+
+func TestApplyBlockErrors(t *testing.T) {
+	fullNode := driver.NewDriver(test.NewDummyExecutor(), testConfig())
+	ctx := context.Background()
+
+	block := &driver.Block{Height: 1, Time: time.Now(), StateRoot: types.Hash{1}}
+	assert.ErrorIs(t, fullNode.ApplyBlock(ctx, block), types.ErrChainNotInitialized)
+
+	require.NoError(t, fullNode.InitChain(ctx))
+	block.Height = 2
+	assert.ErrorIs(t, fullNode.ApplyBlock(ctx, block), types.ErrNonSequentialBlock)
+}
+
+func TestProduceBlocks(t *testing.T) {
+	exec := test.NewDummyExecutor()
+	sequencer := driver.NewDriver(exec, testConfig())
+	ctx := context.Background()
+
+	_, err := sequencer.ProduceBlocks(ctx, []time.Time{time.Now()})
+	assert.ErrorIs(t, err, types.ErrChainNotInitialized)
+	require.NoError(t, sequencer.InitChain(ctx))
+
+	var expected []types.Tx
+	for i := 0; i < 10; i++ {
+		tx := types.Tx(fmt.Sprintf("tx-%d", i))
+		exec.InjectTx(tx)
+		expected = append(expected, tx)
+	}
+	now := time.Now()
+	blocks, err := sequencer.ProduceBlocks(ctx, []time.Time{now, now, now})
+	require.NoError(t, err)
+	require.Len(t, blocks, 3)
+
+	// transactions fetched during execution of the first block are not included twice
+	assert.Equal(t, expected, blocks[0].Txs)
+	assert.Empty(t, blocks[1].Txs)
+	assert.Equal(t, uint64(3), sequencer.Height())
+	assert.Equal(t, blocks[2].StateRoot, sequencer.StateRoot())
+
+	store := &blockStore{blocks: make(map[uint64]*driver.Block)}
+	for _, block := range blocks {
+		store.blocks[block.Height] = block
+	}
+	syncCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	store.onMissing = cancel
+	fullNode := driver.NewDriver(test.NewDummyExecutor(), testConfig())
+	require.NoError(t, fullNode.InitChain(ctx))
+	assert.ErrorIs(t, fullNode.Sync(syncCtx, store), context.Canceled)
+	assert.Equal(t, blocks[2].StateRoot, fullNode.StateRoot())
+}
+
+// pipelineRecorder records submitted and finalized blocks of an asynchronous executor.
+type pipelineRecorder struct {
+	*test.DummyExecutor
+	async execution.AsyncExecutor
+
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *pipelineRecorder) record(format string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, fmt.Sprintf(format, args...))
+}
+
+func (r *pipelineRecorder) ExecuteTxsAsync(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot types.Hash) *execution.Future {
+	r.record("submit %d chained=%t", blockHeight, prevStateRoot == nil)
+	return r.async.ExecuteTxsAsync(ctx, txs, blockHeight, timestamp, prevStateRoot)
+}
+
+func (r *pipelineRecorder) SetFinal(ctx context.Context, blockHeight uint64) error {
+	r.record("final %d", blockHeight)
+	return r.DummyExecutor.SetFinal(ctx, blockHeight)
+}
+
+func TestProduceBlocksPipelined(t *testing.T) {
+	dummy := test.NewDummyExecutor()
+	exec := &pipelineRecorder{DummyExecutor: dummy, async: execution.NewAsyncExecutor(dummy)}
+	sequencer := driver.NewDriver(exec, testConfig())
+	ctx := context.Background()
+	require.NoError(t, sequencer.InitChain(ctx))
+	_, err := sequencer.ProduceBlock(ctx, time.Now())
+	require.NoError(t, err)
+	exec.events = nil
+
+	now := time.Now()
+	blocks, err := sequencer.ProduceBlocks(ctx, []time.Time{now, now, now})
+	require.NoError(t, err)
+	require.Len(t, blocks, 3)
+
+	// all blocks are submitted before the first one is finalized, the first one on top of the last block
+	assert.Equal(t, []string{
+		"submit 2 chained=false", "submit 3 chained=true", "submit 4 chained=true",
+		"final 2", "final 3", "final 4",
+	}, exec.events)
+	for i, block := range blocks {
+		stateRoot, finalized, err := dummy.StateRootAt(ctx, block.Height)
+		require.NoError(t, err)
+		assert.True(t, finalized)
+		assert.Equal(t, stateRoot, block.StateRoot, "block %d", i)
+	}
+}
+
+// recordingPruner records retain heights passed to Prune, failing with err if it's set.
+type recordingPruner struct {
+	*test.DummyExecutor
+	retainHeights []uint64
+	err           error
+}
+
+func (p *recordingPruner) Prune(ctx context.Context, retainHeight, keepEvery uint64) error {
+	p.retainHeights = append(p.retainHeights, retainHeight)
+	if p.err != nil {
+		return p.err
+	}
+	return p.DummyExecutor.Prune(ctx, retainHeight, keepEvery)
+}
+
+// withoutCapabilities reports that the executor supports no optional capability.
+type withoutCapabilities struct {
+	*recordingPruner
+}
+
+func (withoutCapabilities) Capabilities() ([]string, bool) {
+	return nil, true
+}
+
+func TestPruning(t *testing.T) {
+	for name, tc := range map[string]struct {
+		keepRecent, keepEvery uint64
+		expected              []uint64
+		pruned                []uint64
+	}{
+		"disabled":    {keepRecent: 0, expected: nil, pruned: nil},
+		"keep recent": {keepRecent: 3, expected: []uint64{2, 3, 4, 5, 6, 7, 8, 9, 10}, pruned: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		"keep every":  {keepRecent: 3, keepEvery: 4, expected: []uint64{2, 3, 4, 5, 6, 7, 8, 9, 10}, pruned: []uint64{1, 2, 3, 5, 6, 7, 9}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			exec := &recordingPruner{DummyExecutor: test.NewDummyExecutor()}
+			config := testConfig()
+			config.PruneKeepRecent = tc.keepRecent
+			config.PruneKeepEvery = tc.keepEvery
+			sequencer := driver.NewDriver(exec, config)
+			ctx := context.Background()
+			require.NoError(t, sequencer.InitChain(ctx))
+
+			for i := 0; i < 12; i++ {
+				_, err := sequencer.ProduceBlock(ctx, time.Now())
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, exec.retainHeights)
+
+			for height := uint64(1); height <= 12; height++ {
+				_, _, err := exec.StateRootAt(ctx, height)
+				if slices.Contains(tc.pruned, height) {
+					assert.ErrorIs(t, err, types.ErrBlockNotFound, "height %d", height)
+				} else {
+					assert.NoError(t, err, "height %d", height)
+				}
+			}
+		})
+	}
+}
+
+func TestPruningUnsupported(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err      error
+		reporter bool
+		expected []uint64
+	}{
+		"unimplemented":           {err: status.Error(codes.Unimplemented, "pruning not supported"), expected: []uint64{2}},
+		"other error":             {err: errors.New("disk full"), expected: []uint64{2, 3, 4, 5}},
+		"capability not reported": {reporter: true, expected: nil},
+	} {
+		t.Run(name, func(t *testing.T) {
+			pruner := &recordingPruner{DummyExecutor: test.NewDummyExecutor(), err: tc.err}
+			var exec execution.Executor = pruner
+			if tc.reporter {
+				exec = withoutCapabilities{pruner}
+			}
+			config := testConfig()
+			config.PruneKeepRecent = 3
+			sequencer := driver.NewDriver(exec, config)
+			ctx := context.Background()
+			require.NoError(t, sequencer.InitChain(ctx))
+
+			for i := 0; i < 7; i++ {
+				_, err := sequencer.ProduceBlock(ctx, time.Now())
+				require.NoError(t, err)
+			}
+			// unsupported pruning is tried at most once, failed pruning is retried after every block
+			assert.Equal(t, tc.expected, pruner.retainHeights)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package engine
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Config holds configuration settings for the Engine API executor.
+type Config struct {
+	// EngineURL is the URL of authenticated Engine API endpoint of the execution client.
+	EngineURL string
+	// EthURL is the URL of JSON-RPC endpoint serving eth_ and txpool_ namespaces. EngineURL is used if empty.
+	EthURL string
+	// JWTSecret is the secret shared with the execution client, used to authenticate Engine API calls.
+	JWTSecret []byte
+	// FeeRecipient is the address receiving transaction fees of produced blocks.
+	FeeRecipient [20]byte
+	// GasLimit is the gas limit of produced blocks. Execution client default is used if zero.
+	GasLimit uint64
+	// MaxBytes is the maximum allowed bytes for transactions in a block, reported by InitChain and ExecuteTxs.
+	MaxBytes uint64
+	// DefaultTimeout is used for calls made with context without deadline.
+	DefaultTimeout time.Duration
+}
+
+// DefaultConfig returns a Config instance populated with default settings.
+func DefaultConfig() *Config {
+	return &Config{
+		EngineURL:      "http://127.0.0.1:8551",
+		EthURL:         "http://127.0.0.1:8545",
+		MaxBytes:       2 * 1024 * 1024,
+		DefaultTimeout: 5 * time.Second,
+	}
+}
+
+// ReadJWTSecret reads hex encoded 32 byte JWT secret from file, in the format used by Ethereum execution clients.
+func ReadJWTSecret(path string) ([]byte, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	secret, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT secret: %w", err)
+	}
+	if len(secret) != 32 {
+		return nil, fmt.Errorf("invalid JWT secret length: expected 32 bytes, got %d", len(secret))
+	}
+	return secret, nil
+}
@@ -0,0 +1,402 @@
+// Package engine implements execution.Executor on top of an Ethereum execution client,
+// driven over authenticated Engine API JSON-RPC.
+package engine
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rollkit/go-execution"
+	"github.com/rollkit/go-execution/types"
+)
+
+// Payload statuses defined by Engine API.
+const (
+	statusValid    = "VALID"
+	statusInvalid  = "INVALID"
+	statusSyncing  = "SYNCING"
+	statusAccepted = "ACCEPTED"
+)
+
+type hexBytes = types.HexBytes
+
+type forkchoiceState struct {
+	HeadBlockHash      hexBytes `json:"headBlockHash"`
+	SafeBlockHash      hexBytes `json:"safeBlockHash"`
+	FinalizedBlockHash hexBytes `json:"finalizedBlockHash"`
+}
+
+// payloadAttributes are PayloadAttributesV3 extended with fields used by rollup execution clients
+// (op-geth, op-reth) to build a block from given transactions instead of the local transaction pool.
+type payloadAttributes struct {
+	Timestamp             quantity   `json:"timestamp"`
+	PrevRandao            hexBytes   `json:"prevRandao"`
+	SuggestedFeeRecipient hexBytes   `json:"suggestedFeeRecipient"`
+	Withdrawals           []any      `json:"withdrawals"`
+	ParentBeaconBlockRoot hexBytes   `json:"parentBeaconBlockRoot"`
+	Transactions          []hexBytes `json:"transactions"`
+	NoTxPool              bool       `json:"noTxPool"`
+	GasLimit              *quantity  `json:"gasLimit,omitempty"`
+}
+
+type payloadStatus struct {
+	Status          string  `json:"status"`
+	ValidationError *string `json:"validationError"`
+}
+
+type forkchoiceUpdatedResult struct {
+	PayloadStatus payloadStatus `json:"payloadStatus"`
+	PayloadID     hexBytes      `json:"payloadId"`
+}
+
+type getPayloadResult struct {
+	// ExecutionPayload is passed back to engine_newPayloadV3 exactly as received.
+	ExecutionPayload json.RawMessage `json:"executionPayload"`
+}
+
+// executionPayload contains only fields of ExecutionPayloadV3 used by Executor.
+type executionPayload struct {
+	BlockHash   hexBytes `json:"blockHash"`
+	StateRoot   hexBytes `json:"stateRoot"`
+	BlockNumber quantity `json:"blockNumber"`
+}
+
+type block struct {
+	Hash      hexBytes `json:"hash"`
+	StateRoot hexBytes `json:"stateRoot"`
+	Number    quantity `json:"number"`
+}
+
+type txPoolContent struct {
+	Pending map[string]map[string]struct {
+		Hash hexBytes `json:"hash"`
+	} `json:"pending"`
+}
+
+// Executor implements execution.Executor by driving an Ethereum execution client over Engine API.
+//
+// ExecuteTxs builds a block with exactly the given transactions (forkchoiceUpdated with payload attributes,
+// getPayload, newPayload) and makes it canonical head. SetFinal marks the block as safe and finalized via
+// forkchoiceUpdated. GetTxs returns pending transactions from the execution client transaction pool.
+type Executor struct {
+	engine *rpcClient
+	eth    *rpcClient
+	config *Config
+
+	mu          sync.Mutex
+	head        types.Hash
+	finalized   types.Hash
+	finalHeight uint64
+}
+
+var (
+	_ execution.Executor      = (*Executor)(nil)
+	_ execution.HealthChecker = (*Executor)(nil)
+)
+
+// NewExecutor creates a new Executor with the given configuration.
+// Optional HTTP client can be provided; http.DefaultClient is used otherwise.
+func NewExecutor(config *Config, httpClient ...*http.Client) *Executor {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	client := http.DefaultClient
+	if len(httpClient) > 0 && httpClient[0] != nil {
+		client = httpClient[0]
+	}
+	ethURL := config.EthURL
+	if ethURL == "" {
+		ethURL = config.EngineURL
+	}
+	return &Executor{
+		engine: newRPCClient(config.EngineURL, config.JWTSecret, client),
+		eth:    newRPCClient(ethURL, config.JWTSecret, client),
+		config: config,
+	}
+}
+
+// InitChain uses block at initialHeight-1 (usually the genesis block) as the parent of the first rollup block.
+// It returns state root of this block.
+func (e *Executor) InitChain(ctx context.Context, genesisTime time.Time, initialHeight uint64, chainID string) (types.Hash, uint64, error) {
+	if initialHeight == 0 {
+		return types.Hash{}, 0, types.ErrZeroInitialHeight
+	}
+	if chainID == "" {
+		return types.Hash{}, 0, types.ErrEmptyChainID
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	genesis, err := e.blockByNumber(ctx, initialHeight-1)
+	if err != nil {
+		return types.Hash{}, 0, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.head = types.Hash(genesis.Hash)
+	e.finalized = types.Hash(genesis.Hash)
+	e.finalHeight = initialHeight - 1
+	return types.Hash(genesis.StateRoot), e.config.MaxBytes, nil
+}
+
+// GetTxs returns pending transactions from the transaction pool of execution client, ordered by sender and nonce.
+func (e *Executor) GetTxs(ctx context.Context) ([]types.Tx, error) {
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	var content txPoolContent
+	if err := e.eth.call(ctx, &content, "txpool_content"); err != nil {
+		return nil, err
+	}
+
+	senders := make([]string, 0, len(content.Pending))
+	for sender := range content.Pending {
+		senders = append(senders, sender)
+	}
+	sort.Strings(senders)
+
+	var calls []rpcCall
+	for _, sender := range senders {
+		txs := content.Pending[sender]
+		nonces := make([]uint64, 0, len(txs))
+		for nonce := range txs {
+			n, err := strconv.ParseUint(nonce, 0, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid nonce %q: %w", nonce, err)
+			}
+			nonces = append(nonces, n)
+		}
+		slices.Sort(nonces)
+		for _, n := range nonces {
+			calls = append(calls, rpcCall{
+				method: "eth_getRawTransactionByHash",
+				params: []any{txs[strconv.FormatUint(n, 10)].Hash},
+				result: new(hexBytes),
+			})
+		}
+	}
+
+	if err := e.eth.batch(ctx, calls); err != nil {
+		return nil, err
+	}
+	txs := make([]types.Tx, 0, len(calls))
+	for _, call := range calls {
+		// transaction could be removed from the pool in the meantime
+		if call.err != nil {
+			continue
+		}
+		if raw := *call.result.(*hexBytes); len(raw) > 0 {
+			txs = append(txs, types.Tx(raw))
+		}
+	}
+	return txs, nil
+}
+
+// ExecuteTxs builds block at blockHeight on top of the parent block with given transactions, and makes it
+// canonical head of the chain. prevStateRoot must match state root of the parent block.
+func (e *Executor) ExecuteTxs(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot types.Hash) (types.Hash, uint64, error) {
+	if blockHeight == 0 {
+		return types.Hash{}, 0, types.ErrInvalidBlockHeight
+	}
+	if len(prevStateRoot) == 0 {
+		return types.Hash{}, 0, types.ErrEmptyStateRoot
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	parent, err := e.blockByNumber(ctx, blockHeight-1)
+	if err != nil {
+		return types.Hash{}, 0, err
+	}
+	if !bytes.Equal(parent.StateRoot, prevStateRoot) {
+		return types.Hash{}, 0, fmt.Errorf("%w: parent %x, given %x", ErrStateRootMismatch, []byte(parent.StateRoot), prevStateRoot)
+	}
+
+	attrs := &payloadAttributes{
+		Timestamp:             quantity(timestamp.Unix()), //nolint:gosec
+		PrevRandao:            make(hexBytes, 32),
+		SuggestedFeeRecipient: e.config.FeeRecipient[:],
+		Withdrawals:           []any{},
+		ParentBeaconBlockRoot: make(hexBytes, 32),
+		Transactions:          make([]hexBytes, len(txs)),
+		NoTxPool:              true,
+	}
+	for i, tx := range txs {
+		attrs.Transactions[i] = hexBytes(tx)
+	}
+	if e.config.GasLimit > 0 {
+		gasLimit := quantity(e.config.GasLimit)
+		attrs.GasLimit = &gasLimit
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	fcuResult, err := e.forkchoiceUpdated(ctx, parent.Hash, e.safeHash(parent.Hash), attrs)
+	if err != nil {
+		return types.Hash{}, 0, err
+	}
+	if len(fcuResult.PayloadID) == 0 {
+		return types.Hash{}, 0, ErrNoPayloadID
+	}
+
+	var built getPayloadResult
+	if err := e.engine.call(ctx, &built, "engine_getPayloadV3", fcuResult.PayloadID); err != nil {
+		return types.Hash{}, 0, err
+	}
+	var payload executionPayload
+	if err := json.Unmarshal(built.ExecutionPayload, &payload); err != nil {
+		return types.Hash{}, 0, fmt.Errorf("invalid execution payload: %w", err)
+	}
+
+	var status payloadStatus
+	err = e.engine.call(ctx, &status, "engine_newPayloadV3", built.ExecutionPayload, []hexBytes{}, attrs.ParentBeaconBlockRoot)
+	if err != nil {
+		return types.Hash{}, 0, err
+	}
+	if err := checkStatus(status); err != nil {
+		return types.Hash{}, 0, err
+	}
+
+	if _, err := e.forkchoiceUpdated(ctx, payload.BlockHash, e.safeHash(payload.BlockHash), nil); err != nil {
+		return types.Hash{}, 0, err
+	}
+	e.head = types.Hash(payload.BlockHash)
+
+	return types.Hash(payload.StateRoot), e.config.MaxBytes, nil
+}
+
+// SetFinal marks block at given height as safe and finalized.
+// Heights at or below the last finalized height are already final, and SetFinal does nothing for them.
+func (e *Executor) SetFinal(ctx context.Context, blockHeight uint64) error {
+	e.mu.Lock()
+	final := e.finalized != nil && blockHeight <= e.finalHeight
+	e.mu.Unlock()
+	if final {
+		return nil
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	b, err := e.blockByNumber(ctx, blockHeight)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.finalized != nil && blockHeight <= e.finalHeight {
+		// a later block was finalized concurrently
+		return nil
+	}
+
+	head := e.head
+	if head == nil {
+		head = types.Hash(b.Hash)
+	}
+	if _, err := e.forkchoiceUpdatedWithState(ctx, forkchoiceState{
+		HeadBlockHash:      hexBytes(head),
+		SafeBlockHash:      b.Hash,
+		FinalizedBlockHash: b.Hash,
+	}, nil); err != nil {
+		return err
+	}
+	e.finalized = types.Hash(b.Hash)
+	e.finalHeight = blockHeight
+	return nil
+}
+
+// CheckHealth reports executor as ready when chain is initialized and execution client is not syncing.
+func (e *Executor) CheckHealth(ctx context.Context) error {
+	e.mu.Lock()
+	initialized := e.head != nil
+	e.mu.Unlock()
+	if !initialized {
+		return types.ErrChainNotInitialized
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	// eth_syncing returns false when node is not syncing, and sync status object otherwise
+	var syncing json.RawMessage
+	if err := e.eth.call(ctx, &syncing, "eth_syncing"); err != nil {
+		return err
+	}
+	if !bytes.Equal(syncing, []byte("false")) {
+		return ErrSyncing
+	}
+	return nil
+}
+
+// safeHash returns hash of latest finalized block, or fallback if nothing is finalized.
+func (e *Executor) safeHash(fallback hexBytes) hexBytes {
+	if e.finalized == nil {
+		return fallback
+	}
+	return hexBytes(e.finalized)
+}
+
+func (e *Executor) forkchoiceUpdated(ctx context.Context, head, safe hexBytes, attrs *payloadAttributes) (*forkchoiceUpdatedResult, error) {
+	return e.forkchoiceUpdatedWithState(ctx, forkchoiceState{
+		HeadBlockHash:      head,
+		SafeBlockHash:      safe,
+		FinalizedBlockHash: safe,
+	}, attrs)
+}
+
+func (e *Executor) forkchoiceUpdatedWithState(ctx context.Context, state forkchoiceState, attrs *payloadAttributes) (*forkchoiceUpdatedResult, error) {
+	var result forkchoiceUpdatedResult
+	if err := e.engine.call(ctx, &result, "engine_forkchoiceUpdatedV3", state, attrs); err != nil {
+		return nil, err
+	}
+	if err := checkStatus(result.PayloadStatus); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (e *Executor) blockByNumber(ctx context.Context, number uint64) (*block, error) {
+	var b *block
+	if err := e.eth.call(ctx, &b, "eth_getBlockByNumber", quantity(number), false); err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, fmt.Errorf("%w: height %d", types.ErrBlockNotFound, number)
+	}
+	return b, nil
+}
+
+func (e *Executor) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || e.config.DefaultTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, e.config.DefaultTimeout)
+}
+
+func checkStatus(status payloadStatus) error {
+	switch status.Status {
+	case statusValid:
+		return nil
+	case statusInvalid:
+		if status.ValidationError != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidPayload, *status.ValidationError)
+		}
+		return ErrInvalidPayload
+	case statusSyncing, statusAccepted:
+		return ErrSyncing
+	}
+	return fmt.Errorf("unexpected payload status %q", status.Status)
+}
@@ -0,0 +1,171 @@
+package engine_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-execution/engine"
+	"github.com/rollkit/go-execution/types"
+)
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func setup(t *testing.T) (*engine.Executor, *fakeEL) {
+	t.Helper()
+	el := newFakeEL(testSecret)
+	server := httptest.NewServer(el)
+	t.Cleanup(server.Close)
+
+	config := engine.DefaultConfig()
+	config.EngineURL = server.URL
+	config.EthURL = server.URL
+	config.JWTSecret = testSecret
+	return engine.NewExecutor(config, server.Client()), el
+}
+
+func TestBlockProduction(t *testing.T) {
+	exec, el := setup(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	genesisTime := time.Now().UTC()
+	stateRoot, maxBytes, err := exec.InitChain(ctx, genesisTime, 1, "test-chain")
+	require.NoError(t, err)
+	assert.Equal(t, []byte(el.head().StateRoot), stateRoot)
+	assert.Greater(t, maxBytes, uint64(0))
+
+	el.addTx("0x02", types.Tx("b0"))
+	el.addTx("0x01", types.Tx("a0"))
+	el.addTx("0x02", types.Tx("b1"))
+
+	txs, err := exec.GetTxs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Tx{types.Tx("a0"), types.Tx("b0"), types.Tx("b1")}, txs)
+
+	for height := uint64(1); height <= 5; height++ {
+		txs, err := exec.GetTxs(ctx)
+		require.NoError(t, err)
+
+		blockTime := genesisTime.Add(time.Duration(height) * time.Second) //nolint:gosec
+		stateRoot, _, err = exec.ExecuteTxs(ctx, txs, height, blockTime, stateRoot)
+		require.NoError(t, err)
+
+		head := el.head()
+		assert.Equal(t, []byte(head.StateRoot), stateRoot)
+		assert.Len(t, head.Txs, len(txs))
+
+		require.NoError(t, exec.SetFinal(ctx, height))
+		assert.Equal(t, []byte(head.Hash), el.finalizedHash())
+
+		el.InjectTx(types.Tx{byte(height)})
+	}
+
+	// all transactions except the last injected one were included in blocks
+	txs, err = exec.GetTxs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Tx{{5}}, txs)
+}
+
+func TestExecuteTxsErrors(t *testing.T) {
+	exec, el := setup(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	stateRoot, _, err := exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+
+	_, _, err = exec.ExecuteTxs(ctx, nil, 1, time.Now(), types.Hash("other root"))
+	assert.ErrorIs(t, err, engine.ErrStateRootMismatch)
+	assert.ErrorIs(t, err, types.ErrStateRootMismatch)
+
+	_, _, err = exec.ExecuteTxs(ctx, nil, 5, time.Now(), stateRoot)
+	assert.ErrorIs(t, err, types.ErrBlockNotFound)
+
+	_, _, err = exec.ExecuteTxs(ctx, nil, 1, time.Now(), types.Hash{})
+	assert.ErrorIs(t, err, types.ErrEmptyStateRoot)
+
+	el.mu.Lock()
+	el.failPayload = true
+	el.mu.Unlock()
+	_, _, err = exec.ExecuteTxs(ctx, nil, 1, time.Now(), stateRoot)
+	assert.ErrorIs(t, err, engine.ErrUnknownPayload)
+
+	err = exec.SetFinal(ctx, 7)
+	assert.ErrorIs(t, err, types.ErrBlockNotFound)
+}
+
+func TestSetFinalBelowFinalizedHeight(t *testing.T) {
+	exec, el := setup(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	genesisTime := time.Now().UTC()
+	stateRoot, _, err := exec.InitChain(ctx, genesisTime, 1, "test-chain")
+	require.NoError(t, err)
+	for height := uint64(1); height <= 3; height++ {
+		stateRoot, _, err = exec.ExecuteTxs(ctx, nil, height, genesisTime.Add(time.Duration(height)*time.Second), stateRoot) //nolint:gosec
+		require.NoError(t, err)
+	}
+	require.NoError(t, exec.SetFinal(ctx, 3))
+	finalized := el.finalizedHash()
+
+	// finality never moves backwards
+	for height := uint64(0); height <= 3; height++ {
+		require.NoError(t, exec.SetFinal(ctx, height))
+		assert.Equal(t, finalized, el.finalizedHash())
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	el := newFakeEL(testSecret)
+	server := httptest.NewServer(el)
+	defer server.Close()
+
+	config := engine.DefaultConfig()
+	config.EngineURL = server.URL
+	config.EthURL = ""
+	config.JWTSecret = []byte("wrong secret")
+	exec := engine.NewExecutor(config)
+
+	_, _, err := exec.InitChain(context.Background(), time.Now().UTC(), 1, "test-chain")
+	assert.ErrorContains(t, err, "401")
+}
+
+func TestCheckHealth(t *testing.T) {
+	exec, el := setup(t)
+	ctx := context.Background()
+
+	assert.ErrorIs(t, exec.CheckHealth(ctx), types.ErrChainNotInitialized)
+
+	_, _, err := exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+	assert.NoError(t, exec.CheckHealth(ctx))
+
+	el.mu.Lock()
+	el.syncing = true
+	el.mu.Unlock()
+	assert.ErrorIs(t, exec.CheckHealth(ctx), engine.ErrSyncing)
+}
+
+func TestReadJWTSecret(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "jwt.hex")
+	require.NoError(t, os.WriteFile(valid, []byte("0xab0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f\n"), 0o600))
+	secret, err := engine.ReadJWTSecret(valid)
+	require.NoError(t, err)
+	assert.Len(t, secret, 32)
+	assert.Equal(t, byte(0xab), secret[0])
+
+	short := filepath.Join(dir, "short.hex")
+	require.NoError(t, os.WriteFile(short, []byte("abcd"), 0o600))
+	_, err = engine.ReadJWTSecret(short)
+	assert.Error(t, err)
+}
@@ -0,0 +1,44 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rollkit/go-execution/types"
+)
+
+// Engine API errors, as defined by the Ethereum Engine API specification.
+var (
+	// ErrUnknownPayload is returned when the payload does not exist or is not available
+	ErrUnknownPayload = errors.New("payload does not exist")
+	// ErrInvalidForkchoice is returned when the forkchoice state is invalid
+	ErrInvalidForkchoice = errors.New("invalid forkchoice state")
+	// ErrInvalidPayloadAttrs is returned when the payload attributes are invalid
+	ErrInvalidPayloadAttrs = errors.New("invalid payload attributes")
+	// ErrTooLargeRequest is returned when the number of requested entities is too large
+	ErrTooLargeRequest = errors.New("request too large")
+	// ErrUnsupportedFork is returned when the payload belongs to a fork not supported by the method
+	ErrUnsupportedFork = errors.New("unsupported fork")
+)
+
+// Executor errors.
+var (
+	// ErrInvalidPayload is returned when the execution client rejects the built payload
+	ErrInvalidPayload = errors.New("invalid payload")
+	// ErrSyncing is returned when the execution client is syncing and can't build or validate payloads
+	ErrSyncing = errors.New("execution client is syncing")
+	// ErrNoPayloadID is returned when the execution client didn't start building a payload
+	ErrNoPayloadID = errors.New("execution client did not return payload ID")
+	// ErrStateRootMismatch is returned when the previous state root doesn't match the parent block.
+	// It wraps types.ErrStateRootMismatch.
+	ErrStateRootMismatch = fmt.Errorf("%w: previous state root doesn't match parent block", types.ErrStateRootMismatch)
+)
+
+// engineErrorCodes maps Engine API JSON-RPC error codes to errors.
+var engineErrorCodes = map[int]error{
+	-38001: ErrUnknownPayload,
+	-38002: ErrInvalidForkchoice,
+	-38003: ErrInvalidPayloadAttrs,
+	-38004: ErrTooLargeRequest,
+	-38005: ErrUnsupportedFork,
+}
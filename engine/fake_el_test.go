@@ -0,0 +1,331 @@
+package engine_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rollkit/go-execution/internal/jwt"
+	"github.com/rollkit/go-execution/types"
+)
+
+type hexBytes = types.HexBytes
+
+type fakeBlock struct {
+	Hash       hexBytes   `json:"hash"`
+	ParentHash hexBytes   `json:"parentHash"`
+	StateRoot  hexBytes   `json:"stateRoot"`
+	Number     string     `json:"number"`
+	Timestamp  string     `json:"timestamp"`
+	Txs        []hexBytes `json:"transactions"`
+
+	number uint64
+}
+
+// fakePayload is ExecutionPayloadV3 representation of fakeBlock.
+type fakePayload struct {
+	BlockHash   hexBytes   `json:"blockHash"`
+	ParentHash  hexBytes   `json:"parentHash"`
+	StateRoot   hexBytes   `json:"stateRoot"`
+	BlockNumber string     `json:"blockNumber"`
+	Timestamp   string     `json:"timestamp"`
+	Txs         []hexBytes `json:"transactions"`
+}
+
+type fakeTx struct {
+	sender string
+	nonce  uint64
+	raw    []byte
+	hash   []byte
+}
+
+type fakeRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+type fakeResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *fakeError      `json:"error,omitempty"`
+}
+
+type fakeError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// fakeEL is an in-process fake of Ethereum execution client, implementing subset of Engine API and
+// eth/txpool JSON-RPC methods used by engine.Executor. Blocks are "executed" by hashing transactions.
+type fakeEL struct {
+	secret []byte
+
+	mu          sync.Mutex
+	canonical   []*fakeBlock
+	known       map[string]*fakeBlock
+	payloads    map[string]*fakeBlock
+	pool        []fakeTx
+	nonces      map[string]uint64
+	safe        []byte
+	finalized   []byte
+	syncing     bool
+	failPayload bool
+	nextPayload uint64
+}
+
+func newFakeEL(secret []byte) *fakeEL {
+	genesis := &fakeBlock{
+		Hash:       hash([]byte("genesis")),
+		ParentHash: make(hexBytes, 32),
+		StateRoot:  hash([]byte("genesis state")),
+		Number:     "0x0",
+		Timestamp:  "0x0",
+		Txs:        []hexBytes{},
+	}
+	return &fakeEL{
+		secret:    secret,
+		canonical: []*fakeBlock{genesis},
+		known:     map[string]*fakeBlock{string(genesis.Hash): genesis},
+		payloads:  make(map[string]*fakeBlock),
+		nonces:    make(map[string]uint64),
+	}
+}
+
+func hash(data ...[]byte) hexBytes {
+	h := sha256.New()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+// InjectTx adds transaction to the pending pool, sent by default sender.
+func (f *fakeEL) InjectTx(tx types.Tx) {
+	f.addTx("0x0000000000000000000000000000000000000001", tx)
+}
+
+func (f *fakeEL) addTx(sender string, tx types.Tx) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	nonce := f.nonces[sender]
+	f.nonces[sender] = nonce + 1
+	f.pool = append(f.pool, fakeTx{sender: sender, nonce: nonce, raw: tx, hash: hash(tx)})
+}
+
+func (f *fakeEL) head() *fakeBlock {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.canonical[len(f.canonical)-1]
+}
+
+func (f *fakeEL) finalizedHash() []byte {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.finalized
+}
+
+func (f *fakeEL) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if err := jwt.Verify(f.secret, token, time.Now()); err != nil {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var raw json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&raw); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if raw[0] == '[' {
+		var reqs []fakeRequest
+		if err := json.Unmarshal(raw, &reqs); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resps := make([]fakeResponse, len(reqs))
+		for i, req := range reqs {
+			resps[i] = f.handle(req)
+		}
+		_ = json.NewEncoder(w).Encode(resps)
+		return
+	}
+
+	var req fakeRequest
+	if err := json.Unmarshal(raw, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(f.handle(req))
+}
+
+func (f *fakeEL) handle(req fakeRequest) fakeResponse {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	result, err := f.dispatch(req)
+	resp := fakeResponse{JSONRPC: "2.0", ID: req.ID, Result: result, Error: err}
+	if err == nil && result == nil {
+		resp.Result = json.RawMessage("null")
+	}
+	return resp
+}
+
+func (f *fakeEL) dispatch(req fakeRequest) (any, *fakeError) {
+	switch req.Method {
+	case "eth_getBlockByNumber":
+		var number string
+		_ = json.Unmarshal(req.Params[0], &number)
+		n, _ := strconv.ParseUint(strings.TrimPrefix(number, "0x"), 16, 64)
+		if n >= uint64(len(f.canonical)) {
+			return nil, nil
+		}
+		return f.canonical[n], nil
+	case "eth_syncing":
+		if f.syncing {
+			return map[string]string{"currentBlock": "0x1", "highestBlock": "0x10"}, nil
+		}
+		return false, nil
+	case "txpool_content":
+		pending := make(map[string]map[string]map[string]hexBytes)
+		for _, tx := range f.pool {
+			if pending[tx.sender] == nil {
+				pending[tx.sender] = make(map[string]map[string]hexBytes)
+			}
+			pending[tx.sender][strconv.FormatUint(tx.nonce, 10)] = map[string]hexBytes{"hash": tx.hash}
+		}
+		return map[string]any{"pending": pending, "queued": map[string]any{}}, nil
+	case "eth_getRawTransactionByHash":
+		var h hexBytes
+		_ = json.Unmarshal(req.Params[0], &h)
+		for _, tx := range f.pool {
+			if bytes.Equal(tx.hash, h) {
+				return hexBytes(tx.raw), nil
+			}
+		}
+		return nil, nil
+	case "engine_forkchoiceUpdatedV3":
+		return f.forkchoiceUpdated(req.Params)
+	case "engine_getPayloadV3":
+		var id hexBytes
+		_ = json.Unmarshal(req.Params[0], &id)
+		b, ok := f.payloads[string(id)]
+		if !ok || f.failPayload {
+			return nil, &fakeError{Code: -38001, Message: "Unknown payload"}
+		}
+		payload := fakePayload{
+			BlockHash:   b.Hash,
+			ParentHash:  b.ParentHash,
+			StateRoot:   b.StateRoot,
+			BlockNumber: b.Number,
+			Timestamp:   b.Timestamp,
+			Txs:         b.Txs,
+		}
+		return map[string]any{"executionPayload": payload, "blockValue": "0x0"}, nil
+	case "engine_newPayloadV3":
+		var p fakePayload
+		if err := json.Unmarshal(req.Params[0], &p); err != nil {
+			return nil, &fakeError{Code: -32602, Message: err.Error()}
+		}
+		parent, ok := f.known[string(p.ParentHash)]
+		if !ok {
+			return map[string]any{"status": "SYNCING"}, nil
+		}
+		if !bytes.Equal(p.BlockHash, blockHash(parent, p.Timestamp, p.StateRoot)) {
+			return map[string]any{"status": "INVALID", "validationError": "invalid block hash"}, nil
+		}
+		f.known[string(p.BlockHash)] = &fakeBlock{
+			Hash:       p.BlockHash,
+			ParentHash: p.ParentHash,
+			StateRoot:  p.StateRoot,
+			Number:     p.BlockNumber,
+			Timestamp:  p.Timestamp,
+			Txs:        p.Txs,
+			number:     parent.number + 1,
+		}
+		return map[string]any{"status": "VALID", "latestValidHash": p.BlockHash}, nil
+	}
+	return nil, &fakeError{Code: -32601, Message: "method not found"}
+}
+
+func (f *fakeEL) forkchoiceUpdated(params []json.RawMessage) (any, *fakeError) {
+	var state struct {
+		Head      hexBytes `json:"headBlockHash"`
+		Safe      hexBytes `json:"safeBlockHash"`
+		Finalized hexBytes `json:"finalizedBlockHash"`
+	}
+	if err := json.Unmarshal(params[0], &state); err != nil {
+		return nil, &fakeError{Code: -32602, Message: err.Error()}
+	}
+	head, ok := f.known[string(state.Head)]
+	if !ok {
+		return map[string]any{"payloadStatus": map[string]any{"status": "SYNCING"}}, nil
+	}
+	if _, ok := f.known[string(state.Finalized)]; !ok {
+		return nil, &fakeError{Code: -38002, Message: "Invalid forkchoice state"}
+	}
+
+	// make head and its ancestors canonical; remove included transactions from the pool
+	f.canonical = f.canonical[:head.number]
+	f.canonical = append(f.canonical, head)
+	for _, tx := range head.Txs {
+		for i, ptx := range f.pool {
+			if bytes.Equal(ptx.raw, tx) {
+				f.pool = append(f.pool[:i], f.pool[i+1:]...)
+				break
+			}
+		}
+	}
+	f.safe = state.Safe
+	f.finalized = state.Finalized
+
+	result := map[string]any{"payloadStatus": map[string]any{"status": "VALID", "latestValidHash": head.Hash}}
+	if len(params) < 2 || string(params[1]) == "null" {
+		result["payloadId"] = nil
+		return result, nil
+	}
+
+	var attrs struct {
+		Timestamp    string     `json:"timestamp"`
+		Transactions []hexBytes `json:"transactions"`
+		NoTxPool     bool       `json:"noTxPool"`
+	}
+	if err := json.Unmarshal(params[1], &attrs); err != nil || !attrs.NoTxPool {
+		return nil, &fakeError{Code: -38003, Message: "Invalid payload attributes"}
+	}
+
+	stateParts := [][]byte{head.StateRoot}
+	for _, tx := range attrs.Transactions {
+		stateParts = append(stateParts, tx)
+	}
+	stateRoot := hash(stateParts...)
+	b := &fakeBlock{
+		ParentHash: head.Hash,
+		StateRoot:  stateRoot,
+		Number:     "0x" + strconv.FormatUint(head.number+1, 16),
+		Timestamp:  attrs.Timestamp,
+		Txs:        attrs.Transactions,
+		number:     head.number + 1,
+	}
+	b.Hash = blockHash(head, attrs.Timestamp, stateRoot)
+
+	f.nextPayload++
+	id := make(hexBytes, 8)
+	binary.BigEndian.PutUint64(id, f.nextPayload)
+	f.payloads[string(id)] = b
+	result["payloadId"] = id
+	return result, nil
+}
+
+func blockHash(parent *fakeBlock, timestamp string, stateRoot []byte) hexBytes {
+	return hash(parent.Hash, []byte(timestamp), stateRoot)
+}
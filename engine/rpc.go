@@ -0,0 +1,192 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/rollkit/go-execution/internal/jwt"
+)
+
+// quantity is an unsigned integer encoded in JSON as 0x-prefixed hex string, as defined by Ethereum JSON-RPC.
+type quantity uint64
+
+// MarshalJSON implements json.Marshaler.
+func (q quantity) MarshalJSON() ([]byte, error) {
+	return json.Marshal("0x" + strconv.FormatUint(uint64(q), 16))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (q *quantity) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := strconv.ParseUint(strings.TrimPrefix(s, "0x"), 16, 64)
+	if err != nil {
+		return fmt.Errorf("invalid quantity %q: %w", s, err)
+	}
+	*q = quantity(v)
+	return nil
+}
+
+type rpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      uint64 `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type rpcResponse struct {
+	ID     uint64          `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+// rpcError is a JSON-RPC error returned by the execution client.
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("json-rpc error %d: %s", e.Code, e.Message)
+}
+
+// Unwrap returns Engine API error corresponding to the error code, if any.
+func (e *rpcError) Unwrap() error {
+	return engineErrorCodes[e.Code]
+}
+
+// rpcCall describes single call in a batch.
+type rpcCall struct {
+	method string
+	params []any
+	result any
+	err    error
+}
+
+// rpcClient is a minimal JSON-RPC 2.0 over HTTP client. If secret is set, every request is authenticated
+// with freshly generated JWT, as required by Engine API.
+type rpcClient struct {
+	url    string
+	secret []byte
+	http   *http.Client
+	nextID atomic.Uint64
+}
+
+func newRPCClient(url string, secret []byte, httpClient *http.Client) *rpcClient {
+	return &rpcClient{url: url, secret: secret, http: httpClient}
+}
+
+// call invokes single method and decodes result into result.
+func (c *rpcClient) call(ctx context.Context, result any, method string, params ...any) error {
+	body, err := json.Marshal(c.newRequest(method, params))
+	if err != nil {
+		return err
+	}
+	respBody, err := c.post(ctx, body)
+	if err != nil {
+		return err
+	}
+
+	var resp rpcResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return fmt.Errorf("failed to decode JSON-RPC response: %w", err)
+	}
+	return decodeResult(&resp, result)
+}
+
+// batch invokes all calls in a single JSON-RPC batch request. Errors of individual calls are stored in rpcCall.err.
+func (c *rpcClient) batch(ctx context.Context, calls []rpcCall) error {
+	if len(calls) == 0 {
+		return nil
+	}
+
+	reqs := make([]rpcRequest, len(calls))
+	for i, call := range calls {
+		reqs[i] = c.newRequest(call.method, call.params)
+	}
+	body, err := json.Marshal(reqs)
+	if err != nil {
+		return err
+	}
+	respBody, err := c.post(ctx, body)
+	if err != nil {
+		return err
+	}
+
+	var resps []rpcResponse
+	if err := json.Unmarshal(respBody, &resps); err != nil {
+		return fmt.Errorf("failed to decode JSON-RPC response: %w", err)
+	}
+	byID := make(map[uint64]*rpcResponse, len(resps))
+	for i := range resps {
+		byID[resps[i].ID] = &resps[i]
+	}
+	for i := range calls {
+		resp, ok := byID[reqs[i].ID]
+		if !ok {
+			calls[i].err = fmt.Errorf("missing response for %s", calls[i].method)
+			continue
+		}
+		calls[i].err = decodeResult(resp, calls[i].result)
+	}
+	return nil
+}
+
+func (c *rpcClient) newRequest(method string, params []any) rpcRequest {
+	if params == nil {
+		params = []any{}
+	}
+	return rpcRequest{JSONRPC: "2.0", ID: c.nextID.Add(1), Method: method, Params: params}
+}
+
+func decodeResult(resp *rpcResponse, result any) error {
+	if resp.Error != nil {
+		return resp.Error
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(resp.Result, result)
+}
+
+func (c *rpcClient) post(ctx context.Context, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.secret != nil {
+		token, err := jwt.New(c.secret, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status %s: %s", resp.Status, bytes.TrimSpace(respBody))
+	}
+	return respBody, nil
+}
@@ -1,7 +1,10 @@
 package execution
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
+	"fmt"
 	"time"
 
 	"github.com/rollkit/go-execution/types"
@@ -72,7 +75,9 @@ type Executor interface {
 	// SetFinal marks a block as finalized at the specified height.
 	// Requirements:
 	// - Must verify block exists at specified height
-	// - Must be idempotent
+	// - Must also finalize all pending blocks below the specified height the block descends from
+	// - Must be idempotent; finalizing an already finalized height, including heights below the last
+	//   finalized one, must succeed and have no effect
 	// - Must maintain finality guarantees (no reverting finalized blocks)
 	// - Must respect context cancellation/timeout
 	// - Should clean up any temporary state/resources
@@ -85,3 +90,353 @@ type Executor interface {
 	// - error: Any errors during finalization
 	SetFinal(ctx context.Context, blockHeight uint64) error
 }
+
+// HealthChecker is an optional interface that can be implemented by an Executor to report its readiness.
+// Executors that don't implement HealthChecker are always considered ready.
+type HealthChecker interface {
+	// CheckHealth reports whether the executor is ready to process Execution API calls.
+	// Requirements:
+	// - Must return nil only if executor is ready to serve requests
+	// - Should return error before InitChain was called or while the execution layer is syncing
+	// - Must respect context cancellation/timeout
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	//
+	// Returns:
+	// - error: nil if executor is ready, reason why it's not ready otherwise
+	CheckHealth(ctx context.Context) error
+}
+
+// LimitedTxGetter is an optional interface that can be implemented by an Executor to return only transactions
+// fitting into a block, instead of the whole mempool.
+type LimitedTxGetter interface {
+	// GetTxsWithLimits fetches available transactions from the execution layer's mempool, like GetTxs.
+	// Requirements:
+	// - Must follow all requirements of GetTxs
+	// - Must not return more transactions than allowed by any of the limits
+	// - Must return transactions in the same order as GetTxs, stopping before the first transaction that
+	//   would exceed the limits
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - limits: Limits of returned transactions; zero value of a limit means no limit
+	//
+	// Returns:
+	// - []types.Tx: Slice of valid transactions within the limits
+	// - error: Any errors during transaction retrieval
+	GetTxsWithLimits(ctx context.Context, limits types.TxLimits) ([]types.Tx, error)
+}
+
+// PaginatedTxGetter is an optional interface that can be implemented by an Executor to return transactions
+// page by page, for mempools too large to be returned in a single response.
+type PaginatedTxGetter interface {
+	// GetTxsPage fetches a page of available transactions from the execution layer's mempool.
+	// Requirements:
+	// - Must follow all requirements of GetTxs
+	// - Must return the first page for empty pageToken, and the following page for nextPageToken returned before
+	// - Must return empty nextPageToken for the last page
+	// - Must not return more transactions than allowed by the limits, except that a page must contain at least
+	//   one transaction if any follows pageToken
+	// - Must return types.ErrInvalidPageToken if pageToken is malformed
+	// - Should keep tokens valid when transactions are added to or removed from the mempool
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - pageToken: Token of the requested page, empty for the first page
+	// - limits: Limits of transactions in the page; zero value of a limit means no limit
+	//
+	// Returns:
+	// - []types.Tx: Slice of valid transactions in the page
+	// - nextPageToken: Token of the next page, empty if this is the last page
+	// - error: Any errors during transaction retrieval
+	GetTxsPage(ctx context.Context, pageToken []byte, limits types.TxLimits) (txs []types.Tx, nextPageToken []byte, err error)
+}
+
+// Simulator is an optional interface that can be implemented by an Executor to preview the outcome of a block,
+// e.g. to select transactions when proposing a block.
+type Simulator interface {
+	// SimulateTxs executes transactions like ExecuteTxs, without persisting any state.
+	// Requirements:
+	// - Must validate and execute transactions exactly like ExecuteTxs
+	// - Must not register pending block at blockHeight or change any other state
+	// - Must report transactions that would be rejected, instead of failing for them
+	// - Must respect context cancellation/timeout
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - txs: Ordered list of transactions to execute
+	// - blockHeight: Height of simulated block (must be > 0)
+	// - timestamp: Block creation time in UTC
+	// - prevStateRoot: Previous block's state root hash
+	//
+	// Returns:
+	// - result: State root the block would produce, rejected transactions and gas used
+	// - err: Any errors that would make ExecuteTxs fail
+	SimulateTxs(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot types.Hash) (result *types.SimulationResult, err error)
+}
+
+// BatchExecutor is an optional interface that can be implemented by an Executor to execute multiple blocks in
+// one call, e.g. to catch up with the chain.
+type BatchExecutor interface {
+	// ExecuteBlocks executes blocks in order, like consecutive calls of ExecuteTxs.
+	// Requirements:
+	// - Must execute every block exactly like ExecuteTxs
+	// - Must execute the first block on top of prevStateRoot, and every next block on top of the previous one
+	// - Must stop at the first failed block and return *types.BatchError with its index
+	// - Must keep blocks executed before the failed one, like ExecuteTxs would
+	// - Must respect context cancellation/timeout
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - blocks: Ordered list of blocks to execute
+	// - prevStateRoot: State root of the block preceding the first block
+	//
+	// Returns:
+	// - stateRoots: State roots of executed blocks, also when a block failed
+	// - maxBytes: Maximum allowed transaction size returned by the last executed block
+	// - err: *types.BatchError if any block failed
+	ExecuteBlocks(ctx context.Context, blocks []types.BatchBlock, prevStateRoot types.Hash) (stateRoots []types.Hash, maxBytes uint64, err error)
+}
+
+// ExecuteBlocks executes blocks with exec. If exec doesn't implement BatchExecutor, blocks are executed one by one
+// with ExecuteTxs.
+func ExecuteBlocks(ctx context.Context, exec Executor, blocks []types.BatchBlock, prevStateRoot types.Hash) ([]types.Hash, uint64, error) {
+	if batch, ok := supports[BatchExecutor](exec, CapabilityBatchExecution); ok {
+		return batch.ExecuteBlocks(ctx, blocks, prevStateRoot)
+	}
+
+	var (
+		stateRoots = make([]types.Hash, 0, len(blocks))
+		maxBytes   uint64
+	)
+	for i, block := range blocks {
+		stateRoot, blockMaxBytes, err := exec.ExecuteTxs(ctx, block.Txs, block.Height, block.Timestamp, prevStateRoot)
+		if err != nil {
+			return stateRoots, maxBytes, &types.BatchError{Index: i, Err: err}
+		}
+		stateRoots = append(stateRoots, stateRoot)
+		maxBytes = blockMaxBytes
+		prevStateRoot = stateRoot
+	}
+	return stateRoots, maxBytes, nil
+}
+
+// Verifier is an optional interface that can be implemented by an Executor to verify blocks replayed by full
+// nodes, which know the state root produced by the sequencer.
+type Verifier interface {
+	// VerifyBlock executes transactions like ExecuteTxs and checks that the resulting state root is expectedRoot.
+	// Requirements:
+	// - Must execute transactions exactly like ExecuteTxs
+	// - Must return *types.StateRootMismatchError if the resulting state root differs from expectedRoot
+	// - Must not register the block if verification failed
+	// - Must register the block like ExecuteTxs if verification succeeded, so it can be finalized
+	// - Must respect context cancellation/timeout
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - txs: Ordered list of transactions in the block
+	// - blockHeight: Height of the block (must be > 0)
+	// - timestamp: Block creation time in UTC
+	// - prevStateRoot: Previous block's state root hash
+	// - expectedRoot: State root produced by the block according to the sequencer
+	//
+	// Returns:
+	// - err: *types.StateRootMismatchError if state roots differ, or any execution errors
+	VerifyBlock(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot, expectedRoot types.Hash) error
+}
+
+// VerifyBlock verifies block with exec. If exec doesn't implement Verifier, block is executed with ExecuteTxs
+// and its state root is compared with expectedRoot; the block remains pending even if state roots differ.
+func VerifyBlock(ctx context.Context, exec Executor, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot, expectedRoot types.Hash) error {
+	if verifier, ok := supports[Verifier](exec, CapabilityVerification); ok {
+		return verifier.VerifyBlock(ctx, txs, blockHeight, timestamp, prevStateRoot, expectedRoot)
+	}
+	stateRoot, _, err := exec.ExecuteTxs(ctx, txs, blockHeight, timestamp, prevStateRoot)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(stateRoot, expectedRoot) {
+		return &types.StateRootMismatchError{Height: blockHeight, Expected: expectedRoot, Actual: stateRoot}
+	}
+	return nil
+}
+
+// Pruner is an optional interface that can be implemented by an Executor to discard historical state of
+// finalized blocks.
+type Pruner interface {
+	// Prune removes historical state of blocks below retainHeight, except for checkpoints at multiples of keepEvery.
+	// Requirements:
+	// - Must keep state of retainHeight and all blocks above it
+	// - Must keep state of finalized heights below retainHeight that are multiples of keepEvery, if keepEvery is not 0
+	// - Must not fail if state of a checkpoint was already removed by a previous call with different keepEvery
+	// - Must return error wrapping types.ErrInvalidBlockHeight if retainHeight is above the last finalized height
+	// - Must be idempotent; retainHeight lower than in previous calls must not fail
+	// - Must respect context cancellation/timeout
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - retainHeight: Lowest height whose state must be kept
+	// - keepEvery: Interval of checkpoint heights kept below retainHeight, or 0 to keep none
+	//
+	// Returns:
+	// - error: Any errors during pruning
+	Prune(ctx context.Context, retainHeight, keepEvery uint64) error
+}
+
+// StateQuerier is an optional interface that can be implemented by an Executor to report historical state
+// roots and progress of the chain.
+type StateQuerier interface {
+	// StateRootAt returns the state root after the block at given height.
+	// Requirements:
+	// - Must return the genesis state root for initial height - 1
+	// - Must return root of the finalized block if the height has both finalized and pending blocks
+	// - Must return error wrapping types.ErrBlockNotFound if the block was not executed or was pruned
+	// - Must respect context cancellation/timeout
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - height: Height of the block
+	//
+	// Returns:
+	// - stateRoot: State root after the block
+	// - finalized: True if the block is finalized
+	// - err: Any errors during the lookup
+	StateRootAt(ctx context.Context, height uint64) (stateRoot types.Hash, finalized bool, err error)
+
+	// ChainStatus returns the latest executed and finalized heights.
+	// Requirements:
+	// - Must return types.ErrChainNotInitialized before InitChain
+	// - Must respect context cancellation/timeout
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	//
+	// Returns:
+	// - *types.ChainStatus: Current status of the chain
+	// - error: Any errors during the lookup
+	ChainStatus(ctx context.Context) (*types.ChainStatus, error)
+}
+
+// RangeFinalizer is an optional interface that can be implemented by an Executor to finalize a range of blocks
+// in a single call.
+type RangeFinalizer interface {
+	// SetFinalRange marks blocks from fromHeight to toHeight, inclusive, as finalized.
+	// Requirements:
+	// - Must finalize blocks exactly like SetFinal called for every height in the range, in order
+	// - Must stop at the first height that can't be finalized; blocks below it remain finalized
+	// - Must return error wrapping types.ErrInvalidBlockHeight if fromHeight is 0 or above toHeight
+	// - Must respect context cancellation/timeout
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - fromHeight: Height of the first block to finalize
+	// - toHeight: Height of the last block to finalize
+	//
+	// Returns:
+	// - error: Any errors during finalization
+	SetFinalRange(ctx context.Context, fromHeight, toHeight uint64) error
+}
+
+// SetFinalRange finalizes blocks from fromHeight to toHeight with exec. If exec doesn't implement
+// RangeFinalizer, SetFinal is called for every height in the range.
+func SetFinalRange(ctx context.Context, exec Executor, fromHeight, toHeight uint64) error {
+	if fromHeight == 0 || fromHeight > toHeight {
+		return fmt.Errorf("%w: invalid range %d-%d", types.ErrInvalidBlockHeight, fromHeight, toHeight)
+	}
+	if finalizer, ok := supports[RangeFinalizer](exec, CapabilityRangeFinality); ok {
+		return finalizer.SetFinalRange(ctx, fromHeight, toHeight)
+	}
+	for height := fromHeight; ; height++ {
+		if err := exec.SetFinal(ctx, height); err != nil {
+			return fmt.Errorf("set final %d: %w", height, err)
+		}
+		if height == toHeight {
+			return nil
+		}
+	}
+}
+
+// TxInfoGetter is an optional interface that can be implemented by an Executor to return transactions with
+// metadata, so they can be ordered by fee or sender.
+type TxInfoGetter interface {
+	// GetTxsInfo fetches available transactions with metadata from the execution layer's mempool.
+	// Requirements:
+	// - Must follow all requirements of GetTxs
+	// - Must return the same transactions in the same order as GetTxs
+	// - Must set Hash and Size of every transaction; other metadata may be left empty if unknown
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	//
+	// Returns:
+	// - []types.TxInfo: Slice of valid transactions with metadata
+	// - error: Any errors during transaction retrieval
+	GetTxsInfo(ctx context.Context) ([]types.TxInfo, error)
+}
+
+// GetTxsInfo fetches transactions with metadata from exec. If exec doesn't implement TxInfoGetter, metadata
+// of transactions returned by GetTxs is created with types.NewTxInfo.
+func GetTxsInfo(ctx context.Context, exec Executor) ([]types.TxInfo, error) {
+	if getter, ok := supports[TxInfoGetter](exec, CapabilityTxInfo); ok {
+		return getter.GetTxsInfo(ctx)
+	}
+	txs, err := exec.GetTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]types.TxInfo, len(txs))
+	for i, tx := range txs {
+		infos[i] = types.NewTxInfo(tx)
+	}
+	return infos, nil
+}
+
+// GetTxsWithLimits fetches transactions from exec that fit into given limits. If exec doesn't implement
+// LimitedTxGetter, transactions returned by GetTxs are trimmed to fit into the limits, except for MaxGas.
+func GetTxsWithLimits(ctx context.Context, exec Executor, limits types.TxLimits) ([]types.Tx, error) {
+	if limits.IsZero() {
+		return exec.GetTxs(ctx)
+	}
+	if getter, ok := supports[LimitedTxGetter](exec, CapabilityLimitedTxs); ok {
+		return getter.GetTxsWithLimits(ctx, limits)
+	}
+	txs, err := exec.GetTxs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return limits.Trim(txs), nil
+}
+
+// GetTxsPage fetches a page of transactions from exec. If exec doesn't implement PaginatedTxGetter, pages are
+// cut from transactions returned by GetTxs, using their offset as the page token, and MaxGas is not applied.
+func GetTxsPage(ctx context.Context, exec Executor, pageToken []byte, limits types.TxLimits) ([]types.Tx, []byte, error) {
+	if getter, ok := supports[PaginatedTxGetter](exec, CapabilityPaginatedTxs); ok {
+		return getter.GetTxsPage(ctx, pageToken, limits)
+	}
+
+	var offset uint64
+	if len(pageToken) != 0 {
+		if len(pageToken) != 8 {
+			return nil, nil, types.ErrInvalidPageToken
+		}
+		offset = binary.BigEndian.Uint64(pageToken)
+	}
+	txs, err := exec.GetTxs(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if offset >= uint64(len(txs)) {
+		return nil, nil, nil
+	}
+
+	txs = txs[offset:]
+	page := limits.Trim(txs)
+	if len(page) == 0 {
+		page = txs[:1]
+	}
+	if len(page) == len(txs) {
+		return page, nil, nil
+	}
+	return page, binary.BigEndian.AppendUint64(nil, offset+uint64(len(page))), nil
+}
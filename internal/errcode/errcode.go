@@ -0,0 +1,66 @@
+// Package errcode defines stable numeric codes of sentinel errors from types package, used by proxies
+// to transfer errors over the wire.
+package errcode
+
+import (
+	"errors"
+
+	"github.com/rollkit/go-execution/types"
+)
+
+// codes maps sentinel errors from types package to numeric codes.
+// Codes must never be changed, as they are part of the wire protocols.
+var codes = []struct {
+	code int
+	err  error
+}{
+	{1001, types.ErrZeroInitialHeight},
+	{1002, types.ErrEmptyChainID},
+	{1003, types.ErrInvalidChainID},
+	{1004, types.ErrChainIDTooLong},
+	{1005, types.ErrFutureGenesisTime},
+	{1006, types.ErrChainNotInitialized},
+
+	{2001, types.ErrEmptyStateRoot},
+	{2002, types.ErrFutureBlockTime},
+	{2003, types.ErrInvalidBlockHeight},
+	{2004, types.ErrTxTooLarge},
+	{2005, types.ErrEmptyTx},
+	{2006, types.ErrStateRootMismatch},
+
+	{3001, types.ErrBlockNotFound},
+	{3002, types.ErrBlockAlreadyExists},
+	{3003, types.ErrNonSequentialBlock},
+
+	{4001, types.ErrTxAlreadyExists},
+	{4002, types.ErrTxPoolFull},
+	{4003, types.ErrInvalidTxFormat},
+	{4004, types.ErrInvalidPageToken},
+
+	{5001, types.ErrContextCanceled},
+	{5002, types.ErrContextTimeout},
+
+	{6001, types.ErrSnapshotNotFound},
+	{6002, types.ErrSnapshotRejected},
+	{6003, types.ErrInvalidSnapshotChunk},
+}
+
+// Code returns code of the sentinel error wrapped by err. It returns false if err doesn't wrap any of them.
+func Code(err error) (int, bool) {
+	for _, c := range codes {
+		if errors.Is(err, c.err) {
+			return c.code, true
+		}
+	}
+	return 0, false
+}
+
+// Err returns sentinel error with given code, or nil if code is unknown.
+func Err(code int) error {
+	for _, c := range codes {
+		if c.code == code {
+			return c.err
+		}
+	}
+	return nil
+}
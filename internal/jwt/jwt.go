@@ -0,0 +1,91 @@
+// Package jwt implements HS256 JSON Web Tokens with "iat" claim validation, as used by Ethereum Engine API
+// authentication and by the Execution API proxies.
+package jwt
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// MaxClockDrift is the maximum allowed difference between token issue time and current time.
+// The value is the same as in Ethereum Engine API authentication.
+const MaxClockDrift = 60 * time.Second
+
+// ErrInvalidToken is returned when token is malformed, signature is invalid or token is expired.
+var ErrInvalidToken = errors.New("invalid JWT token")
+
+type header struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+}
+
+type claims struct {
+	IssuedAt int64 `json:"iat"`
+}
+
+var encoding = base64.RawURLEncoding
+
+// New creates HS256 signed token, with "iat" claim set to given time.
+func New(secret []byte, issuedAt time.Time) (string, error) {
+	h, err := json.Marshal(header{Alg: "HS256", Typ: "JWT"})
+	if err != nil {
+		return "", err
+	}
+	c, err := json.Marshal(claims{IssuedAt: issuedAt.Unix()})
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := encoding.EncodeToString(h) + "." + encoding.EncodeToString(c)
+	return signingInput + "." + encoding.EncodeToString(signature(secret, signingInput)), nil
+}
+
+// Verify checks HS256 signature of the token and validates that "iat" claim is within MaxClockDrift from now.
+func Verify(secret []byte, token string, now time.Time) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ErrInvalidToken
+	}
+
+	sig, err := encoding.DecodeString(parts[2])
+	if err != nil {
+		return ErrInvalidToken
+	}
+	if !hmac.Equal(sig, signature(secret, parts[0]+"."+parts[1])) {
+		return ErrInvalidToken
+	}
+
+	var h header
+	if err := decode(parts[0], &h); err != nil || h.Alg != "HS256" {
+		return ErrInvalidToken
+	}
+	var c claims
+	if err := decode(parts[1], &c); err != nil {
+		return ErrInvalidToken
+	}
+
+	drift := now.Sub(time.Unix(c.IssuedAt, 0))
+	if drift > MaxClockDrift || drift < -MaxClockDrift {
+		return ErrInvalidToken
+	}
+	return nil
+}
+
+func decode(part string, v any) error {
+	data, err := encoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func signature(secret []byte, signingInput string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signingInput))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,48 @@
+package mempool
+
+import (
+	"time"
+
+	"github.com/rollkit/go-execution/types"
+)
+
+// Config holds configuration settings for the mempool.
+type Config struct {
+	// MaxTxs is the maximum number of transactions in the mempool; 0 means unlimited.
+	MaxTxs int
+	// MaxBytes is the maximum total size of transactions in the mempool; 0 means unlimited.
+	MaxBytes uint64
+	// MaxTxBytes is the maximum size of a single transaction; 0 means unlimited.
+	MaxTxBytes uint64
+
+	// TTLNumBlocks is the number of blocks after which transaction is evicted; 0 disables the eviction.
+	TTLNumBlocks uint64
+	// TTLDuration is the time after which transaction is evicted; 0 disables the eviction.
+	TTLDuration time.Duration
+
+	// Comparator defines order of transactions returned by Reap. ByPriority is used if nil.
+	Comparator Comparator
+	// Validate is called for every added transaction. If it returns error, transaction is rejected
+	// with types.ErrInvalidTxFormat.
+	Validate func(tx types.Tx) error
+	// Gas returns gas of transaction, used to apply gas limit in ReapWithLimits. Gas limit is ignored if nil.
+	Gas func(tx types.Tx) uint64
+	// Sender returns sender and nonce of transaction, reported by ReapInfo. They are unknown if nil.
+	Sender func(tx types.Tx) (sender []byte, nonce uint64)
+
+	// WALPath is the path of the write-ahead log used by Open; empty path disables persistence.
+	WALPath string
+	// WALSync enables syncing of the write-ahead log to disk after every write. Without it, the log
+	// survives crash of the process, but not of the operating system.
+	WALSync bool
+}
+
+// DefaultConfig returns a Config instance populated with default settings.
+func DefaultConfig() *Config {
+	return &Config{
+		MaxTxs:     5000,
+		MaxBytes:   64 * 1024 * 1024,
+		MaxTxBytes: 1024 * 1024,
+		Comparator: ByPriority,
+	}
+}
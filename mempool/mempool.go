@@ -0,0 +1,451 @@
+// Package mempool implements an in-memory transaction pool that can be embedded by executors.
+package mempool
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/rollkit/go-execution/types"
+)
+
+// Entry is a transaction stored in the mempool.
+type Entry struct {
+	Tx       types.Tx
+	Priority int64
+	// Height is the height of the mempool (see Update) when transaction was added.
+	Height uint64
+	// Time is the time when transaction was added.
+	Time time.Time
+
+	key [sha256.Size]byte
+	seq uint64
+}
+
+// Comparator reports whether entry a should be reaped before entry b.
+type Comparator func(a, b *Entry) bool
+
+// ByPriority orders entries by descending priority, and by arrival for equal priorities.
+func ByPriority(a, b *Entry) bool {
+	if a.Priority != b.Priority {
+		return a.Priority > b.Priority
+	}
+	return a.seq < b.seq
+}
+
+// FIFO orders entries by arrival.
+func FIFO(a, b *Entry) bool {
+	return a.seq < b.seq
+}
+
+// Mempool is a set of pending transactions, deduplicated by hash and ordered by Config.Comparator.
+//
+// When the mempool is full, a new transaction evicts the lowest ordered transactions if it's ordered
+// before all of them; otherwise types.ErrTxPoolFull is returned. Mempool is safe for concurrent use.
+type Mempool struct {
+	config *Config
+
+	mu      sync.Mutex
+	entries []*Entry
+	byKey   map[[sha256.Size]byte]*Entry
+	bytes   uint64
+	height  uint64
+	nextSeq uint64
+
+	// wal is nil unless mempool was created with Open
+	wal *wal
+}
+
+// New creates a new, empty Mempool.
+func New(config *Config) *Mempool {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	// copy, so defaults don't modify config of the caller
+	c := *config
+	if c.Comparator == nil {
+		c.Comparator = ByPriority
+	}
+	return &Mempool{
+		config: &c,
+		byKey:  make(map[[sha256.Size]byte]*Entry),
+	}
+}
+
+// Add adds transaction with zero priority to the mempool.
+func (m *Mempool) Add(tx types.Tx) error {
+	return m.AddWithPriority(tx, 0)
+}
+
+// AddWithPriority adds transaction with given priority to the mempool.
+func (m *Mempool) AddWithPriority(tx types.Tx, priority int64) error {
+	if err := m.check(tx); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.add(tx, priority)
+}
+
+// check validates transaction before it's added to the mempool.
+func (m *Mempool) check(tx types.Tx) error {
+	if len(tx) == 0 {
+		return types.ErrEmptyTx
+	}
+	if m.config.MaxTxBytes > 0 && uint64(len(tx)) > m.config.MaxTxBytes {
+		return types.ErrTxTooLarge
+	}
+	if m.config.Validate != nil {
+		if err := m.config.Validate(tx); err != nil {
+			return fmt.Errorf("%w: %w", types.ErrInvalidTxFormat, err)
+		}
+	}
+	return nil
+}
+
+func (m *Mempool) add(tx types.Tx, priority int64) error {
+	if m.wal != nil {
+		m.maybeCompact()
+		if m.wal.err != nil {
+			return fmt.Errorf("write-ahead log: %w", m.wal.err)
+		}
+	}
+
+	entry := &Entry{
+		Tx:       tx,
+		Priority: priority,
+		Height:   m.height,
+		Time:     time.Now(),
+		key:      sha256.Sum256(tx),
+		seq:      m.nextSeq,
+	}
+	if _, ok := m.byKey[entry.key]; ok {
+		return types.ErrTxAlreadyExists
+	}
+	evicted, err := m.evictions(entry)
+	if err != nil {
+		return err
+	}
+	// evict only after the entry is logged, so failed write leaves the mempool intact
+	if m.wal != nil {
+		if err := m.wal.add(entry); err != nil {
+			return fmt.Errorf("write-ahead log: %w", err)
+		}
+	}
+	for _, e := range evicted {
+		m.removeEntry(e)
+	}
+
+	m.nextSeq++
+	i, _ := slices.BinarySearchFunc(m.entries, entry, m.compare)
+	m.entries = slices.Insert(m.entries, i, entry)
+	m.byKey[entry.key] = entry
+	m.bytes += uint64(len(tx))
+	return nil
+}
+
+// evictions returns lowest ordered entries that must be evicted to make room for entry, if all of them are
+// ordered after it.
+func (m *Mempool) evictions(entry *Entry) ([]*Entry, error) {
+	count, size := len(m.entries), m.bytes
+	evict := 0
+	for m.exceedsLimits(count+1, size+uint64(len(entry.Tx))) {
+		if evict == len(m.entries) {
+			return nil, types.ErrTxPoolFull
+		}
+		last := m.entries[len(m.entries)-1-evict]
+		if !m.config.Comparator(entry, last) {
+			return nil, types.ErrTxPoolFull
+		}
+		evict++
+		count--
+		size -= uint64(len(last.Tx))
+	}
+	return slices.Clone(m.entries[len(m.entries)-evict:]), nil
+}
+
+func (m *Mempool) exceedsLimits(count int, size uint64) bool {
+	return (m.config.MaxTxs > 0 && count > m.config.MaxTxs) || (m.config.MaxBytes > 0 && size > m.config.MaxBytes)
+}
+
+// compare is a three-way comparison of entries, as required by slices package.
+func (m *Mempool) compare(a, b *Entry) int {
+	switch {
+	case m.config.Comparator(a, b):
+		return -1
+	case m.config.Comparator(b, a):
+		return 1
+	}
+	return 0
+}
+
+// Has reports whether transaction is in the mempool.
+func (m *Mempool) Has(tx types.Tx) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, ok := m.byKey[sha256.Sum256(tx)]
+	return ok
+}
+
+// Size returns the number of transactions in the mempool.
+func (m *Mempool) Size() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.entries)
+}
+
+// SizeBytes returns the total size of transactions in the mempool.
+func (m *Mempool) SizeBytes() uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.bytes
+}
+
+// Txs returns all transactions, in order defined by Config.Comparator. Transactions aren't removed.
+func (m *Mempool) Txs() []types.Tx {
+	return m.Reap(0)
+}
+
+// Reap returns transactions in order defined by Config.Comparator, stopping before the first transaction that
+// would exceed maxBytes in total. Zero maxBytes means no limit. Transactions aren't removed from the mempool.
+func (m *Mempool) Reap(maxBytes uint64) []types.Tx {
+	return m.ReapWithLimits(types.TxLimits{MaxBytes: maxBytes})
+}
+
+// ReapWithLimits returns transactions in order defined by Config.Comparator, stopping before the first
+// transaction that would exceed any of the limits. MaxGas is applied only if Config.Gas is set.
+// Transactions aren't removed from the mempool.
+func (m *Mempool) ReapWithLimits(limits types.TxLimits) []types.Tx {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var txs []types.Tx
+	for _, e := range m.reap(limits) {
+		txs = append(txs, e.Tx)
+	}
+	return txs
+}
+
+// ReapInfo returns the same transactions as ReapWithLimits, with their metadata. Sender and nonce are set
+// only if Config.Sender is set.
+func (m *Mempool) ReapInfo(limits types.TxLimits) []types.TxInfo {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var infos []types.TxInfo
+	for _, e := range m.reap(limits) {
+		info := types.TxInfo{
+			Tx:       e.Tx,
+			Hash:     slices.Clone(e.key[:]),
+			Priority: e.Priority,
+			Size:     uint64(len(e.Tx)),
+		}
+		if m.config.Sender != nil {
+			info.Sender, info.Nonce = m.config.Sender(e.Tx)
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+func (m *Mempool) reap(limits types.TxLimits) []*Entry {
+	m.evictExpired()
+
+	var size, gas uint64
+	for i, e := range m.entries {
+		if limits.MaxTxs > 0 && uint64(i) >= limits.MaxTxs {
+			return m.entries[:i]
+		}
+		if limits.MaxBytes > 0 && size+uint64(len(e.Tx)) > limits.MaxBytes {
+			return m.entries[:i]
+		}
+		if limits.MaxGas > 0 && m.config.Gas != nil {
+			txGas := m.config.Gas(e.Tx)
+			if gas+txGas > limits.MaxGas {
+				return m.entries[:i]
+			}
+			gas += txGas
+		}
+		size += uint64(len(e.Tx))
+	}
+	return m.entries
+}
+
+// pageTokenSize is the size of page token: hash, priority and sequence number of the last transaction of the page.
+const pageTokenSize = sha256.Size + 16
+
+// ReapPage returns a page of transactions following the page that returned pageToken, in order defined by
+// Config.Comparator; empty token requests the first page. Limits apply to the page, but the page contains
+// at least one transaction if any follows the token. Returned token is empty for the last page.
+//
+// Token remains valid after its transaction is removed, as long as Config.Comparator orders transactions
+// only by priority and arrival (like ByPriority and FIFO). Transactions added after the previous page was
+// reaped are returned only if they are ordered after the token.
+func (m *Mempool) ReapPage(pageToken []byte, limits types.TxLimits) ([]types.Tx, []byte, error) {
+	if len(pageToken) != 0 && len(pageToken) != pageTokenSize {
+		return nil, nil, types.ErrInvalidPageToken
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.evictExpired()
+
+	start := 0
+	if len(pageToken) != 0 {
+		last := &Entry{
+			Priority: int64(binary.BigEndian.Uint64(pageToken[sha256.Size:])), //nolint:gosec
+			seq:      binary.BigEndian.Uint64(pageToken[sha256.Size+8:]),
+		}
+		copy(last.key[:], pageToken)
+		if e, ok := m.byKey[last.key]; ok {
+			start = m.index(e) + 1
+		} else {
+			start, _ = slices.BinarySearchFunc(m.entries, last, m.compare)
+		}
+	}
+
+	var (
+		txs       []types.Tx
+		size, gas uint64
+	)
+	end := start
+	for ; end < len(m.entries); end++ {
+		e := m.entries[end]
+		var txGas uint64
+		if m.config.Gas != nil {
+			txGas = m.config.Gas(e.Tx)
+		}
+		if len(txs) > 0 {
+			if limits.MaxTxs > 0 && uint64(len(txs)) >= limits.MaxTxs {
+				break
+			}
+			if limits.MaxBytes > 0 && size+uint64(len(e.Tx)) > limits.MaxBytes {
+				break
+			}
+			if limits.MaxGas > 0 && m.config.Gas != nil && gas+txGas > limits.MaxGas {
+				break
+			}
+		}
+		gas += txGas
+		size += uint64(len(e.Tx))
+		txs = append(txs, e.Tx)
+	}
+	if end == len(m.entries) {
+		return txs, nil, nil
+	}
+
+	last := m.entries[end-1]
+	token := make([]byte, 0, pageTokenSize)
+	token = append(token, last.key[:]...)
+	token = binary.BigEndian.AppendUint64(token, uint64(last.Priority)) //nolint:gosec
+	token = binary.BigEndian.AppendUint64(token, last.seq)
+	return txs, token, nil
+}
+
+// Remove removes transactions from the mempool. Transactions not in the mempool are ignored.
+func (m *Mempool) Remove(txs []types.Tx) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(txs)
+}
+
+// Update should be called after block at given height was executed, with transactions included in the block.
+// It removes included transactions and evicts transactions that exceeded their TTL.
+func (m *Mempool) Update(height uint64, included []types.Tx) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.height = height
+	m.remove(included)
+	m.evictExpired()
+}
+
+// Filter removes all transactions for which keep returns false, and returns the number of removed transactions.
+// keep is called in order defined by Config.Comparator, with the mempool locked.
+func (m *Mempool) Filter(keep func(types.Tx) bool) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.removeFunc(func(e *Entry) bool { return !keep(e.Tx) })
+}
+
+// Flush removes all transactions from the mempool.
+func (m *Mempool) Flush() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries = nil
+	m.byKey = make(map[[sha256.Size]byte]*Entry)
+	m.bytes = 0
+	if m.wal != nil && m.wal.file != nil {
+		m.wal.compact(nil)
+	}
+}
+
+func (m *Mempool) remove(txs []types.Tx) {
+	for _, tx := range txs {
+		if e, ok := m.byKey[sha256.Sum256(tx)]; ok {
+			m.removeEntry(e)
+		}
+	}
+	m.maybeCompact()
+}
+
+func (m *Mempool) removeEntry(e *Entry) {
+	i := m.index(e)
+	m.entries = slices.Delete(m.entries, i, i+1)
+	delete(m.byKey, e.key)
+	m.bytes -= uint64(len(e.Tx))
+	if m.wal != nil {
+		m.wal.remove(e)
+	}
+}
+
+// index returns index of entry in the mempool.
+func (m *Mempool) index(e *Entry) int {
+	i, found := slices.BinarySearchFunc(m.entries, e, m.compare)
+	if !found || m.entries[i] != e {
+		// entries equal according to comparator; fall back to linear search
+		i = slices.Index(m.entries, e)
+	}
+	return i
+}
+
+func (m *Mempool) evictExpired() {
+	if m.config.TTLNumBlocks == 0 && m.config.TTLDuration == 0 {
+		return
+	}
+	now := time.Now()
+	m.removeFunc(func(e *Entry) bool {
+		return (m.config.TTLNumBlocks > 0 && m.height >= e.Height+m.config.TTLNumBlocks) ||
+			(m.config.TTLDuration > 0 && now.Sub(e.Time) >= m.config.TTLDuration)
+	})
+}
+
+func (m *Mempool) removeFunc(drop func(*Entry) bool) int {
+	removed := 0
+	m.entries = slices.DeleteFunc(m.entries, func(e *Entry) bool {
+		if !drop(e) {
+			return false
+		}
+		delete(m.byKey, e.key)
+		m.bytes -= uint64(len(e.Tx))
+		if m.wal != nil {
+			m.wal.remove(e)
+		}
+		removed++
+		return true
+	})
+	m.maybeCompact()
+	return removed
+}
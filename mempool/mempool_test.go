@@ -0,0 +1,209 @@
+package mempool_test
+
+import (
+	"crypto/sha256"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-execution/mempool"
+	"github.com/rollkit/go-execution/types"
+)
+
+func txs(strs ...string) []types.Tx {
+	result := make([]types.Tx, len(strs))
+	for i, s := range strs {
+		result[i] = types.Tx(s)
+	}
+	return result
+}
+
+func TestAdd(t *testing.T) {
+	config := mempool.DefaultConfig()
+	config.MaxTxBytes = 4
+	config.Validate = func(tx types.Tx) error {
+		if tx[0] == '!' {
+			return errors.New("invalid prefix")
+		}
+		return nil
+	}
+	mp := mempool.New(config)
+
+	assert.ErrorIs(t, mp.Add(nil), types.ErrEmptyTx)
+	assert.ErrorIs(t, mp.Add(types.Tx("12345")), types.ErrTxTooLarge)
+	assert.ErrorIs(t, mp.Add(types.Tx("!tx")), types.ErrInvalidTxFormat)
+
+	require.NoError(t, mp.Add(types.Tx("tx1")))
+	assert.ErrorIs(t, mp.Add(types.Tx("tx1")), types.ErrTxAlreadyExists)
+	assert.ErrorIs(t, mp.AddWithPriority(types.Tx("tx1"), 10), types.ErrTxAlreadyExists)
+
+	assert.True(t, mp.Has(types.Tx("tx1")))
+	assert.False(t, mp.Has(types.Tx("tx2")))
+	assert.Equal(t, 1, mp.Size())
+	assert.Equal(t, uint64(3), mp.SizeBytes())
+}
+
+func TestOrdering(t *testing.T) {
+	mp := mempool.New(nil)
+	require.NoError(t, mp.AddWithPriority(types.Tx("low"), 1))
+	require.NoError(t, mp.AddWithPriority(types.Tx("high"), 10))
+	require.NoError(t, mp.AddWithPriority(types.Tx("mid1"), 5))
+	require.NoError(t, mp.AddWithPriority(types.Tx("mid2"), 5))
+	assert.Equal(t, txs("high", "mid1", "mid2", "low"), mp.Txs())
+
+	fifo := mempool.New(&mempool.Config{Comparator: mempool.FIFO})
+	require.NoError(t, fifo.AddWithPriority(types.Tx("low"), 1))
+	require.NoError(t, fifo.AddWithPriority(types.Tx("high"), 10))
+	assert.Equal(t, txs("low", "high"), fifo.Txs())
+}
+
+func TestCapacity(t *testing.T) {
+	mp := mempool.New(&mempool.Config{MaxTxs: 2, MaxBytes: 8})
+
+	require.NoError(t, mp.AddWithPriority(types.Tx("aaa"), 1))
+	require.NoError(t, mp.AddWithPriority(types.Tx("bbb"), 2))
+	assert.ErrorIs(t, mp.AddWithPriority(types.Tx("ccc"), 1), types.ErrTxPoolFull)
+
+	// higher priority transaction evicts the lowest one
+	require.NoError(t, mp.AddWithPriority(types.Tx("ddd"), 3))
+	assert.Equal(t, txs("ddd", "bbb"), mp.Txs())
+
+	// byte limit may require eviction of multiple transactions
+	require.NoError(t, mp.AddWithPriority(types.Tx("eeeeeee"), 4))
+	assert.Equal(t, txs("eeeeeee"), mp.Txs())
+	assert.ErrorIs(t, mp.AddWithPriority(types.Tx("fffffffff"), 5), types.ErrTxPoolFull)
+	assert.Equal(t, uint64(7), mp.SizeBytes())
+}
+
+func TestConfigNotModified(t *testing.T) {
+	config := &mempool.Config{}
+	mempool.New(config)
+	assert.Nil(t, config.Comparator)
+}
+
+func TestReap(t *testing.T) {
+	mp := mempool.New(nil)
+	for _, tx := range txs("aaa", "bb", "cccc", "d") {
+		require.NoError(t, mp.Add(tx))
+	}
+
+	assert.Equal(t, txs("aaa", "bb"), mp.Reap(6))
+	assert.Equal(t, txs("aaa", "bb", "cccc", "d"), mp.Reap(0))
+	assert.Empty(t, mp.Reap(2))
+	assert.Equal(t, 4, mp.Size(), "reaped transactions must stay in the mempool")
+
+	assert.Equal(t, txs("aaa", "bb", "cccc"), mp.ReapWithLimits(types.TxLimits{MaxTxs: 3}))
+	assert.Equal(t, txs("aaa"), mp.ReapWithLimits(types.TxLimits{MaxTxs: 3, MaxBytes: 4}))
+	assert.Len(t, mp.ReapWithLimits(types.TxLimits{MaxGas: 1}), 4, "gas limit is ignored without Config.Gas")
+}
+
+func TestReapGasLimit(t *testing.T) {
+	mp := mempool.New(&mempool.Config{
+		Gas: func(tx types.Tx) uint64 { return uint64(len(tx)) * 10 },
+	})
+	for _, tx := range txs("aaa", "bb", "cccc") {
+		require.NoError(t, mp.Add(tx))
+	}
+
+	assert.Equal(t, txs("aaa", "bb"), mp.ReapWithLimits(types.TxLimits{MaxGas: 50}))
+	assert.Equal(t, txs("aaa"), mp.ReapWithLimits(types.TxLimits{MaxGas: 49}))
+}
+
+func TestReapInfo(t *testing.T) {
+	mp := mempool.New(&mempool.Config{
+		Sender: func(tx types.Tx) ([]byte, uint64) { return tx[:1], uint64(tx[1] - '0') },
+	})
+	require.NoError(t, mp.AddWithPriority(types.Tx("a1"), 1))
+	require.NoError(t, mp.AddWithPriority(types.Tx("b7xx"), 5))
+
+	infos := mp.ReapInfo(types.TxLimits{})
+	require.Len(t, infos, 2)
+	hash := sha256.Sum256([]byte("b7xx"))
+	assert.Equal(t, types.TxInfo{
+		Tx:       types.Tx("b7xx"),
+		Hash:     hash[:],
+		Priority: 5,
+		Sender:   []byte("b"),
+		Nonce:    7,
+		Size:     4,
+	}, infos[0])
+	assert.Equal(t, types.Tx("a1"), infos[1].Tx)
+
+	assert.Len(t, mp.ReapInfo(types.TxLimits{MaxTxs: 1}), 1)
+}
+
+func TestReapPage(t *testing.T) {
+	mp := mempool.New(nil)
+	for _, tx := range txs("a", "b", "c", "dddd", "e") {
+		require.NoError(t, mp.Add(tx))
+	}
+
+	page, token, err := mp.ReapPage(nil, types.TxLimits{MaxTxs: 2})
+	require.NoError(t, err)
+	assert.Equal(t, txs("a", "b"), page)
+	require.NotEmpty(t, token)
+
+	// token remains valid after its transaction is removed
+	mp.Remove(txs("b"))
+	page, token, err = mp.ReapPage(token, types.TxLimits{MaxBytes: 2})
+	require.NoError(t, err)
+	assert.Equal(t, txs("c"), page)
+
+	// page contains at least one transaction
+	page, token, err = mp.ReapPage(token, types.TxLimits{MaxBytes: 2})
+	require.NoError(t, err)
+	assert.Equal(t, txs("dddd"), page)
+
+	page, token, err = mp.ReapPage(token, types.TxLimits{})
+	require.NoError(t, err)
+	assert.Equal(t, txs("e"), page)
+	assert.Empty(t, token)
+
+	_, _, err = mp.ReapPage([]byte("invalid"), types.TxLimits{})
+	assert.ErrorIs(t, err, types.ErrInvalidPageToken)
+}
+
+func TestUpdateAndFilter(t *testing.T) {
+	mp := mempool.New(nil)
+	for _, tx := range txs("a", "b", "c", "d") {
+		require.NoError(t, mp.Add(tx))
+	}
+
+	mp.Update(1, txs("b", "x"))
+	assert.Equal(t, txs("a", "c", "d"), mp.Txs())
+
+	removed := mp.Filter(func(tx types.Tx) bool { return string(tx) != "c" })
+	assert.Equal(t, 1, removed)
+	assert.Equal(t, txs("a", "d"), mp.Txs())
+
+	mp.Remove(txs("a"))
+	assert.Equal(t, txs("d"), mp.Txs())
+
+	mp.Flush()
+	assert.Zero(t, mp.Size())
+	assert.Zero(t, mp.SizeBytes())
+	assert.NoError(t, mp.Add(types.Tx("d")))
+}
+
+func TestTTL(t *testing.T) {
+	mp := mempool.New(&mempool.Config{TTLNumBlocks: 2})
+	require.NoError(t, mp.Add(types.Tx("old")))
+	mp.Update(1, nil)
+	require.NoError(t, mp.Add(types.Tx("new")))
+
+	mp.Update(2, nil)
+	assert.Equal(t, txs("new"), mp.Txs())
+	mp.Update(3, nil)
+	assert.Empty(t, mp.Txs())
+
+	mp = mempool.New(&mempool.Config{TTLDuration: 50 * time.Millisecond})
+	require.NoError(t, mp.Add(types.Tx("tx")))
+	assert.Equal(t, txs("tx"), mp.Txs())
+	assert.Eventually(t, func() bool {
+		return len(mp.Txs()) == 0
+	}, time.Second, 10*time.Millisecond)
+	assert.False(t, mp.Has(types.Tx("tx")))
+}
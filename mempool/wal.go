@@ -0,0 +1,219 @@
+package mempool
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/fs"
+	"os"
+	"slices"
+
+	"github.com/rollkit/go-execution/types"
+)
+
+// Write-ahead log is a sequence of records: 4 bytes of payload length, 4 bytes of payload CRC-32 checksum
+// and the payload. Payload starts with kind of the record followed by priority and transaction for
+// walAdd, or hash of the transaction for walRemove. All integers are big-endian.
+const (
+	walAdd byte = iota + 1
+	walRemove
+
+	walHeaderSize = 8
+)
+
+// minCompactRecords is the minimum number of obsolete records in the log that triggers compaction.
+const minCompactRecords = 128
+
+// Open creates a Mempool persisting its transactions in the write-ahead log at config.WALPath, so pending
+// transactions survive restarts. Transactions found in the log are added back in their original order;
+// transactions rejected by the current config (e.g. by Validate or limits) are dropped. Incomplete record
+// at the end of the log (e.g. after crash during write) is ignored. Time and height of reloaded transactions,
+// used for TTL eviction, are reset.
+//
+// Mempool created with Open must be closed with Close. If config.WALPath is empty, mempool isn't persisted.
+func Open(config *Config) (*Mempool, error) {
+	m := New(config)
+	if m.config.WALPath == "" {
+		return m, nil
+	}
+
+	data, err := os.ReadFile(m.config.WALPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read write-ahead log: %w", err)
+	}
+	for _, r := range replayWAL(data) {
+		if m.check(r.tx) == nil {
+			_ = m.add(r.tx, r.priority)
+		}
+	}
+
+	m.wal = &wal{path: m.config.WALPath, sync: m.config.WALSync}
+	// compaction drops obsolete and rejected records and opens the log for writing
+	m.wal.compact(m.entries)
+	if m.wal.err != nil {
+		return nil, fmt.Errorf("failed to write write-ahead log: %w", m.wal.err)
+	}
+	return m, nil
+}
+
+// Close closes the write-ahead log, if any. It returns the first error of writing to the log that wasn't
+// recovered by compaction.
+func (m *Mempool) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.wal == nil || m.wal.file == nil {
+		return nil
+	}
+	err := errors.Join(m.wal.err, m.wal.file.Close())
+	m.wal.file = nil
+	m.wal.err = os.ErrClosed
+	return err
+}
+
+// maybeCompact rewrites the write-ahead log when most of its records are obsolete, or when writing to the
+// log failed before.
+func (m *Mempool) maybeCompact() {
+	if m.wal == nil || m.wal.file == nil {
+		return
+	}
+	if m.wal.err != nil || m.wal.records > 2*len(m.entries)+minCompactRecords {
+		m.wal.compact(m.entries)
+	}
+}
+
+// wal is a write-ahead log of mempool. Write errors are kept in err, as removals can't fail; Add fails
+// until the log is successfully compacted. A failed write may leave partial record at the end of the log,
+// so nothing is appended until compaction rewrites the log.
+type wal struct {
+	path string
+	sync bool
+
+	file    *os.File
+	records int
+	err     error
+}
+
+func (w *wal) add(e *Entry) error {
+	w.err = w.write(addPayload(e))
+	return w.err
+}
+
+func (w *wal) remove(e *Entry) {
+	if w.err != nil {
+		return
+	}
+	w.err = w.write(append([]byte{walRemove}, e.key[:]...))
+}
+
+func (w *wal) write(payload []byte) error {
+	if _, err := w.file.Write(appendRecord(nil, payload)); err != nil {
+		return err
+	}
+	w.records++
+	if w.sync {
+		return w.file.Sync()
+	}
+	return nil
+}
+
+// compact replaces the log with a new one containing only given entries.
+func (w *wal) compact(entries []*Entry) {
+	tmpPath := w.path + ".tmp"
+	err := w.writeFile(tmpPath, entries)
+	if err == nil {
+		err = os.Rename(tmpPath, w.path)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		w.err = err
+		return
+	}
+
+	if w.file != nil {
+		_ = w.file.Close()
+	}
+	w.file, err = os.OpenFile(w.path, os.O_WRONLY|os.O_APPEND, 0o600)
+	w.records = len(entries)
+	w.err = err
+}
+
+func (w *wal) writeFile(path string, entries []*Entry) error {
+	var data []byte
+	for _, e := range entries {
+		data = appendRecord(data, addPayload(e))
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func addPayload(e *Entry) []byte {
+	payload := make([]byte, 9, 9+len(e.Tx))
+	payload[0] = walAdd
+	binary.BigEndian.PutUint64(payload[1:9], uint64(e.Priority)) //nolint:gosec
+	return append(payload, e.Tx...)
+}
+
+func appendRecord(dst, payload []byte) []byte {
+	dst = binary.BigEndian.AppendUint32(dst, uint32(len(payload))) //nolint:gosec
+	dst = binary.BigEndian.AppendUint32(dst, crc32.ChecksumIEEE(payload))
+	return append(dst, payload...)
+}
+
+type walTx struct {
+	tx       types.Tx
+	priority int64
+	seq      int
+}
+
+// replayWAL returns transactions added and not removed in the log, in order they were added.
+func replayWAL(data []byte) []walTx {
+	live := make(map[[sha256.Size]byte]walTx)
+loop:
+	for seq := 0; len(data) >= walHeaderSize; seq++ {
+		size := binary.BigEndian.Uint32(data)
+		if uint64(len(data)-walHeaderSize) < uint64(size) || size == 0 {
+			break
+		}
+		payload := data[walHeaderSize : walHeaderSize+size]
+		if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(data[4:]) {
+			break
+		}
+		data = data[walHeaderSize+size:]
+
+		switch {
+		case payload[0] == walAdd && len(payload) > 9:
+			tx := types.Tx(payload[9:])
+			live[sha256.Sum256(tx)] = walTx{
+				tx:       tx,
+				priority: int64(binary.BigEndian.Uint64(payload[1:9])), //nolint:gosec
+				seq:      seq,
+			}
+		case payload[0] == walRemove && len(payload) == 1+sha256.Size:
+			delete(live, [sha256.Size]byte(payload[1:]))
+		default:
+			break loop
+		}
+	}
+
+	txs := make([]walTx, 0, len(live))
+	for _, r := range live {
+		txs = append(txs, r)
+	}
+	slices.SortFunc(txs, func(a, b walTx) int { return a.seq - b.seq })
+	return txs
+}
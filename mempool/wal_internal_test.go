@@ -0,0 +1,39 @@
+package mempool
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-execution/types"
+)
+
+func TestWALWriteFailureKeepsEvictionCandidates(t *testing.T) {
+	config := DefaultConfig()
+	config.MaxTxs = 1
+	config.WALPath = filepath.Join(t.TempDir(), "mempool.wal")
+	mp, err := Open(config)
+	require.NoError(t, err)
+	require.NoError(t, mp.AddWithPriority(types.Tx("low"), 1))
+
+	// writes to read-only file fail, while compaction isn't triggered
+	f, err := os.Open(config.WALPath)
+	require.NoError(t, err)
+	require.NoError(t, mp.wal.file.Close())
+	mp.wal.file = f
+
+	err = mp.AddWithPriority(types.Tx("high"), 5)
+	require.Error(t, err)
+	assert.Equal(t, []types.Tx{types.Tx("low")}, mp.Txs())
+	// Close reports the failed write
+	require.Error(t, mp.Close())
+
+	// log is consistent with the mempool
+	reloaded, err := Open(config)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, reloaded.Close()) }()
+	assert.Equal(t, []types.Tx{types.Tx("low")}, reloaded.Txs())
+}
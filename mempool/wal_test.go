@@ -0,0 +1,129 @@
+package mempool_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rollkit/go-execution/mempool"
+	"github.com/rollkit/go-execution/types"
+)
+
+func openMempool(t *testing.T, path string) *mempool.Mempool {
+	t.Helper()
+	config := mempool.DefaultConfig()
+	config.WALPath = path
+	mp, err := mempool.Open(config)
+	require.NoError(t, err)
+	return mp
+}
+
+func TestWALReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mempool.wal")
+
+	mp := openMempool(t, path)
+	require.NoError(t, mp.AddWithPriority(types.Tx("a"), 1))
+	require.NoError(t, mp.AddWithPriority(types.Tx("b"), 5))
+	require.NoError(t, mp.Add(types.Tx("c")))
+	require.NoError(t, mp.Add(types.Tx("d")))
+	mp.Update(1, txs("c"))
+	assert.Equal(t, 1, mp.Filter(func(tx types.Tx) bool { return string(tx) != "d" }))
+	// no Close, to simulate crash
+
+	reloaded := openMempool(t, path)
+	defer func() { require.NoError(t, reloaded.Close()) }()
+	assert.Equal(t, txs("b", "a"), reloaded.Txs())
+
+	// removed transaction can be added again
+	require.NoError(t, reloaded.Add(types.Tx("c")))
+	assert.Equal(t, txs("b", "a", "c"), reloaded.Txs())
+}
+
+func TestWALRevalidation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mempool.wal")
+
+	mp := openMempool(t, path)
+	for _, tx := range txs("ok1", "bad", "ok2") {
+		require.NoError(t, mp.Add(tx))
+	}
+	require.NoError(t, mp.Close())
+
+	config := mempool.DefaultConfig()
+	config.WALPath = path
+	config.Validate = func(tx types.Tx) error {
+		if string(tx) == "bad" {
+			return errors.New("rejected")
+		}
+		return nil
+	}
+	reloaded, err := mempool.Open(config)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, reloaded.Close()) }()
+	assert.Equal(t, txs("ok1", "ok2"), reloaded.Txs())
+}
+
+func TestWALTornWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mempool.wal")
+
+	mp := openMempool(t, path)
+	require.NoError(t, mp.Add(types.Tx("a")))
+	require.NoError(t, mp.Add(types.Tx("b")))
+	require.NoError(t, mp.Close())
+
+	// cut the last record in half
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data[:len(data)-3], 0o600))
+
+	reloaded := openMempool(t, path)
+	assert.Equal(t, txs("a"), reloaded.Txs())
+	require.NoError(t, reloaded.Add(types.Tx("c")))
+	require.NoError(t, reloaded.Close())
+
+	reloaded = openMempool(t, path)
+	defer func() { require.NoError(t, reloaded.Close()) }()
+	assert.Equal(t, txs("a", "c"), reloaded.Txs())
+}
+
+func TestWALCompaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mempool.wal")
+
+	mp := openMempool(t, path)
+	defer func() { require.NoError(t, mp.Close()) }()
+	require.NoError(t, mp.Add(types.Tx("kept")))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	initialSize := info.Size()
+
+	for i := 0; i < 1000; i++ {
+		tx := types.Tx(fmt.Sprintf("tx-%d", i))
+		require.NoError(t, mp.Add(tx))
+		mp.Remove([]types.Tx{tx})
+	}
+
+	info, err = os.Stat(path)
+	require.NoError(t, err)
+	assert.Less(t, info.Size(), 300*initialSize, "log must be compacted")
+
+	mp.Flush()
+	info, err = os.Stat(path)
+	require.NoError(t, err)
+	assert.Zero(t, info.Size())
+}
+
+func TestWALClosed(t *testing.T) {
+	mp := openMempool(t, filepath.Join(t.TempDir(), "mempool.wal"))
+	require.NoError(t, mp.Close())
+	require.NoError(t, mp.Close())
+	assert.ErrorIs(t, mp.Add(types.Tx("a")), os.ErrClosed)
+
+	// mempool without write-ahead log
+	mp = openMempool(t, "")
+	require.NoError(t, mp.Add(types.Tx("a")))
+	require.NoError(t, mp.Close())
+}
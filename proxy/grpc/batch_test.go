@@ -0,0 +1,81 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	grpcproxy "github.com/rollkit/go-execution/proxy/grpc"
+	"github.com/rollkit/go-execution/test"
+	"github.com/rollkit/go-execution/types"
+	pb "github.com/rollkit/go-execution/types/pb/execution"
+)
+
+func testBlocks() []types.BatchBlock {
+	timestamp := time.Now().Truncate(time.Second)
+	return []types.BatchBlock{
+		{Txs: []types.Tx{types.Tx("tx1")}, Height: 1, Timestamp: timestamp},
+		{Txs: []types.Tx{types.Tx("tx2"), types.Tx("tx3")}, Height: 2, Timestamp: timestamp},
+		{Txs: []types.Tx{types.Tx("tx4"), nil}, Height: 3, Timestamp: timestamp},
+	}
+}
+
+func TestExecuteBlocks(t *testing.T) {
+	config := testServerConfig()
+	handle, err := grpcproxy.StartServer(context.Background(), test.NewDummyExecutor(), config)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, handle.Stop()) }()
+	client := startClient(t, handle, config)
+	ctx := context.Background()
+	blocks := testBlocks()
+
+	expected, _, err := test.NewDummyExecutor().ExecuteBlocks(ctx, blocks[:2], types.Hash{1})
+	require.NoError(t, err)
+
+	stateRoots, maxBytes, err := client.ExecuteBlocks(ctx, blocks[:2], types.Hash{1})
+	require.NoError(t, err)
+	assert.Equal(t, expected, stateRoots)
+	assert.NotZero(t, maxBytes)
+
+	stateRoots, _, err = client.ExecuteBlocks(ctx, blocks[2:], stateRoots[1])
+	require.ErrorIs(t, err, types.ErrEmptyTx)
+	var batchErr *types.BatchError
+	require.ErrorAs(t, err, &batchErr)
+	assert.Equal(t, 0, batchErr.Index)
+	assert.Empty(t, stateRoots)
+}
+
+// legacyServer doesn't support batch execution nor range finalization.
+type legacyServer struct {
+	pb.ExecutionServiceServer
+}
+
+func (legacyServer) ExecuteBlocks(context.Context, *pb.ExecuteBlocksRequest) (*pb.ExecuteBlocksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExecuteBlocks not implemented")
+}
+
+func (legacyServer) SetFinalRange(context.Context, *pb.SetFinalRangeRequest) (*pb.SetFinalRangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFinalRange not implemented")
+}
+
+func TestExecuteBlocksFallback(t *testing.T) {
+	client, err := startServiceClient(t, legacyServer{grpcproxy.NewServer(test.NewDummyExecutor(), nil)})
+	require.NoError(t, err)
+	ctx := context.Background()
+	blocks := testBlocks()
+
+	expected, _, err := test.NewDummyExecutor().ExecuteBlocks(ctx, blocks[:2], types.Hash{1})
+	require.NoError(t, err)
+
+	stateRoots, _, err := client.ExecuteBlocks(ctx, blocks, types.Hash{1})
+	require.ErrorIs(t, err, types.ErrEmptyTx)
+	var batchErr *types.BatchError
+	require.ErrorAs(t, err, &batchErr)
+	assert.Equal(t, 2, batchErr.Index)
+	assert.Equal(t, expected, stateRoots)
+}
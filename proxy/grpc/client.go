@@ -2,10 +2,20 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 
+	"github.com/rollkit/go-execution"
 	"github.com/rollkit/go-execution/types"
 	pb "github.com/rollkit/go-execution/types/pb/execution"
 )
@@ -14,9 +24,24 @@ import (
 type Client struct {
 	conn   *grpc.ClientConn
 	client pb.ExecutionServiceClient
+	health healthpb.HealthClient
 	config *Config
+
+	mu   sync.Mutex
+	info *types.ExecutorInfo
+	// legacy is set if the server predates the Info method, so its capabilities are unknown
+	legacy bool
+	// incompatible is the result of failed protocol version check, returned by all calls
+	incompatible error
+	// checking is closed when the protocol version check in progress completes
+	checking chan struct{}
+	// retryAt is the time when protocol version check that didn't reach the server can be retried
+	retryAt time.Time
 }
 
+// handshakeRetryDelay is the minimum delay between protocol version checks that didn't reach the server.
+const handshakeRetryDelay = time.Second
+
 // NewClient creates a new instance of Client with default configuration.
 func NewClient() *Client {
 	return &Client{
@@ -32,16 +57,214 @@ func (c *Client) SetConfig(config *Config) {
 }
 
 // Start initializes the Client by creating a new gRPC connection and storing the ExecutionServiceClient instance.
+// If a Logger is configured, all calls made by the client are logged.
+// If a JWTSecret is configured, every call is authenticated with a freshly generated JWT.
+// If TLS is configured, connection is secured with TLS; transport credentials passed in opts take precedence.
+// Start connects to the server to check its protocol version within Config.DefaultTimeout, and fails with
+// ErrIncompatibleProtocol if it differs from ProtocolVersion. If the server is not reachable yet, the version
+// is checked before the first call made at least a second later instead. Servers predating the Info method
+// are accepted.
 func (c *Client) Start(target string, opts ...grpc.DialOption) error {
-	var err error
+	tlsConfig, err := c.config.clientTLSConfig()
+	if err != nil {
+		return err
+	}
+	if tlsConfig != nil {
+		opts = append([]grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}, opts...)
+	}
+	if c.config.JWTSecret != nil {
+		opts = append(opts, grpc.WithPerRPCCredentials(jwtCredentials{secret: c.config.JWTSecret}))
+	}
+	opts = append(opts,
+		grpc.WithChainUnaryInterceptor(errorUnaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(errorStreamClientInterceptor),
+	)
+	if c.config.Logger != nil {
+		opts = append(opts, grpc.WithChainUnaryInterceptor(LoggingUnaryClientInterceptor(c.config)))
+	}
+	opts = append(opts,
+		grpc.WithChainUnaryInterceptor(c.handshakeUnaryInterceptor),
+		grpc.WithChainStreamInterceptor(c.handshakeStreamInterceptor),
+	)
+
 	c.conn, err = grpc.NewClient(target, opts...)
 	if err != nil {
 		return err
 	}
 	c.client = pb.NewExecutionServiceClient(c.conn)
+	c.health = healthpb.NewHealthClient(c.conn)
+
+	ctx := context.Background()
+	if c.config.DefaultTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.config.DefaultTimeout)
+		defer cancel()
+	}
+	if err := c.handshake(ctx); errors.Is(err, ErrIncompatibleProtocol) {
+		_ = c.conn.Close()
+		return err
+	}
+	return nil
+}
+
+// handshake checks protocol version of the server, unless it was already checked or the last check didn't
+// reach the server less than handshakeRetryDelay ago. Concurrent callers share a single check. It returns
+// error wrapping ErrIncompatibleProtocol if the server speaks a different protocol version.
+func (c *Client) handshake(ctx context.Context) error {
+	c.mu.Lock()
+	if c.info != nil || c.incompatible != nil || time.Now().Before(c.retryAt) {
+		defer c.mu.Unlock()
+		return c.incompatible
+	}
+	checking := c.checking
+	if checking == nil {
+		checking = make(chan struct{})
+		c.checking = checking
+		c.mu.Unlock()
+		// the lock isn't held during the call, so ServerInfo and other calls don't wait for the network
+		c.checkVersion(ctx)
+	} else {
+		c.mu.Unlock()
+	}
+
+	select {
+	case <-checking:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.incompatible
+}
+
+// checkVersion gets server info and stores the result of the protocol version check.
+func (c *Client) checkVersion(ctx context.Context) {
+	info, err := c.Info(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	defer func() {
+		close(c.checking)
+		c.checking = nil
+	}()
+
+	switch {
+	case status.Code(err) == codes.Unimplemented:
+		c.info = &types.ExecutorInfo{ProtocolVersion: ProtocolVersion}
+		c.legacy = true
+	case err != nil:
+		c.retryAt = time.Now().Add(handshakeRetryDelay)
+	case info.ProtocolVersion != ProtocolVersion:
+		c.incompatible = fmt.Errorf("%w: server speaks version %d, client speaks version %d", ErrIncompatibleProtocol, info.ProtocolVersion, ProtocolVersion)
+	default:
+		c.info = info
+	}
+}
+
+// handshakeUnaryInterceptor checks protocol version before Execution API calls, if it couldn't be checked
+// by Start. Other errors of the check are left to the call itself.
+func (c *Client) handshakeUnaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := c.handshakeBefore(ctx, method); err != nil {
+		return err
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// handshakeStreamInterceptor checks protocol version before Execution API streams, if it couldn't be checked
+// by Start. Other errors of the check are left to the call itself.
+func (c *Client) handshakeStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := c.handshakeBefore(ctx, method); err != nil {
+		return nil, err
+	}
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
+func (c *Client) handshakeBefore(ctx context.Context, method string) error {
+	if isExecutionMethod(method) && method != "/"+ServiceName+"/Info" {
+		if err := c.handshake(ctx); errors.Is(err, ErrIncompatibleProtocol) {
+			return err
+		}
+	}
 	return nil
 }
 
+// ServerInfo returns information about the server obtained during the protocol version check, or nil if the
+// version wasn't checked yet. Name and capabilities of servers predating the Info method are empty.
+func (c *Client) ServerInfo() *types.ExecutorInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.info
+}
+
+// Capabilities implements execution.CapabilityReporter. It returns capabilities reported by the server, or
+// false if they are not known, because the protocol version wasn't checked yet or the server predates the
+// Info method.
+func (c *Client) Capabilities() ([]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.info == nil || c.legacy {
+		return nil, false
+	}
+	return slices.Clone(c.info.Capabilities), true
+}
+
+// supports reports whether the server supports capability, or whether its support is unknown.
+func (c *Client) supports(capability string) bool {
+	capabilities, known := c.Capabilities()
+	return !known || slices.Contains(capabilities, capability)
+}
+
+// unsupported returns the error of calls of capability the server doesn't support, without calling it.
+func unsupported(capability string) error {
+	return status.Errorf(codes.Unimplemented, "server doesn't support %s", capability)
+}
+
+// Info returns name, version, protocol version and capabilities of the executor served by the server.
+func (c *Client) Info(ctx context.Context) (*types.ExecutorInfo, error) {
+	resp, err := c.client.Info(ctx, &pb.InfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &types.ExecutorInfo{
+		Name:            resp.Name,
+		Version:         resp.Version,
+		ProtocolVersion: resp.ProtocolVersion,
+		Capabilities:    resp.Capabilities,
+	}, nil
+}
+
+// WaitReady blocks until the server reports the Execution API as SERVING via gRPC health checking
+// or until the context is done.
+func (c *Client) WaitReady(ctx context.Context) error {
+	ticker := time.NewTicker(c.healthCheckInterval())
+	defer ticker.Stop()
+
+	for {
+		resp, err := c.health.Check(ctx, &healthpb.HealthCheckRequest{Service: ServiceName})
+		if err == nil && resp.Status == healthpb.HealthCheckResponse_SERVING {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if err != nil {
+				return fmt.Errorf("%w: %w", ctx.Err(), err)
+			}
+			return fmt.Errorf("%w: server status %s", ctx.Err(), resp.Status)
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Client) healthCheckInterval() time.Duration {
+	if c.config.HealthCheckInterval > 0 {
+		return c.config.HealthCheckInterval
+	}
+	return DefaultConfig().HealthCheckInterval
+}
+
 // Stop stops the client by closing the underlying gRPC connection if it exists.
 func (c *Client) Stop() error {
 	if c.conn != nil {
@@ -82,6 +305,79 @@ func (c *Client) GetTxs(ctx context.Context) ([]types.Tx, error) {
 	return txs, nil
 }
 
+// GetTxsWithLimits retrieves available transactions from the execution client's mempool that fit into given limits.
+// Transactions are also trimmed by the client, in case the server ignores the limits.
+func (c *Client) GetTxsWithLimits(ctx context.Context, limits types.TxLimits) ([]types.Tx, error) {
+	resp, err := c.client.GetTxs(ctx, &pb.GetTxsRequest{
+		MaxBytes: limits.MaxBytes,
+		MaxTxs:   limits.MaxTxs,
+		MaxGas:   limits.MaxGas,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]types.Tx, len(resp.Txs))
+	for i, tx := range resp.Txs {
+		txs[i] = tx
+	}
+
+	return limits.Trim(txs), nil
+}
+
+// GetTxsInfo retrieves all available transactions with metadata from the execution client's mempool. If the server
+// doesn't support transaction metadata, metadata is created with types.NewTxInfo.
+func (c *Client) GetTxsInfo(ctx context.Context) ([]types.TxInfo, error) {
+	resp, err := c.client.GetTxs(ctx, &pb.GetTxsRequest{IncludeInfo: true})
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]types.TxInfo, 0, len(resp.TxInfos)+len(resp.Txs))
+	for _, info := range resp.TxInfos {
+		infos = append(infos, types.TxInfo{
+			Tx:       info.Tx,
+			Hash:     info.Hash,
+			Priority: info.Priority,
+			Sender:   info.Sender,
+			Nonce:    info.Nonce,
+			Size:     info.Size_,
+		})
+	}
+	// server ignoring include_info returns plain transactions
+	for _, tx := range resp.Txs {
+		infos = append(infos, types.NewTxInfo(tx))
+	}
+
+	return infos, nil
+}
+
+// GetTxsPage retrieves a page of transactions from the execution client's mempool; empty pageToken requests
+// the first page. limits.MaxTxs is used as the page size, up to MaxPageSize. Returned token is empty for the
+// last page. See IterateTxs for iteration over all pages.
+func (c *Client) GetTxsPage(ctx context.Context, pageToken []byte, limits types.TxLimits) ([]types.Tx, []byte, error) {
+	pageSize := MaxPageSize
+	if limits.MaxTxs > 0 && limits.MaxTxs < MaxPageSize {
+		pageSize = int(limits.MaxTxs) //nolint:gosec
+	}
+	resp, err := c.client.GetTxs(ctx, &pb.GetTxsRequest{
+		MaxBytes:  limits.MaxBytes,
+		MaxGas:    limits.MaxGas,
+		PageToken: pageToken,
+		PageSize:  uint32(pageSize), //nolint:gosec
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txs := make([]types.Tx, len(resp.Txs))
+	for i, tx := range resp.Txs {
+		txs[i] = tx
+	}
+
+	return txs, resp.NextPageToken, nil
+}
+
 // ExecuteTxs executes a set of transactions to produce a new block header.
 func (c *Client) ExecuteTxs(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot types.Hash) (types.Hash, uint64, error) {
 	req := &pb.ExecuteTxsRequest{
@@ -105,6 +401,124 @@ func (c *Client) ExecuteTxs(ctx context.Context, txs []types.Tx, blockHeight uin
 	return updatedStateRoot, resp.MaxBytes, nil
 }
 
+// SimulateTxs previews execution of a set of transactions, without creating a block. It fails with Unimplemented
+// error if the executor doesn't support simulation, without calling the server if it reported its capabilities.
+func (c *Client) SimulateTxs(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot types.Hash) (*types.SimulationResult, error) {
+	if !c.supports(execution.CapabilitySimulation) {
+		return nil, unsupported(execution.CapabilitySimulation)
+	}
+	req := &pb.SimulateTxsRequest{
+		Txs:           make([][]byte, len(txs)),
+		BlockHeight:   blockHeight,
+		Timestamp:     timestamp.Unix(),
+		PrevStateRoot: prevStateRoot,
+	}
+	for i, tx := range txs {
+		req.Txs[i] = tx
+	}
+
+	resp, err := c.client.SimulateTxs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.SimulationResult{
+		StateRoot: resp.StateRoot,
+		MaxBytes:  resp.MaxBytes,
+		GasUsed:   resp.GasUsed,
+	}
+	for _, r := range resp.RejectedTxs {
+		result.RejectedTxs = append(result.RejectedTxs, types.RejectedTx{Index: int(r.Index), Reason: r.Reason})
+	}
+	return result, nil
+}
+
+// ExecuteBlocks executes blocks in order in a single call, the first on top of prevStateRoot. It returns
+// *types.BatchError with index of the first failed block, and state roots of blocks executed before it.
+// If the server doesn't support batch execution, blocks are executed one by one with ExecuteTxs.
+func (c *Client) ExecuteBlocks(ctx context.Context, blocks []types.BatchBlock, prevStateRoot types.Hash) ([]types.Hash, uint64, error) {
+	if !c.supports(execution.CapabilityBatchExecution) {
+		return c.executeBlocksOneByOne(ctx, blocks, prevStateRoot)
+	}
+	req := &pb.ExecuteBlocksRequest{
+		Blocks:        make([]*pb.BatchBlock, len(blocks)),
+		PrevStateRoot: prevStateRoot,
+	}
+	for i, block := range blocks {
+		req.Blocks[i] = &pb.BatchBlock{
+			Txs:         make([][]byte, len(block.Txs)),
+			BlockHeight: block.Height,
+			Timestamp:   block.Timestamp.Unix(),
+		}
+		for j, tx := range block.Txs {
+			req.Blocks[i].Txs[j] = tx
+		}
+	}
+
+	resp, err := c.client.ExecuteBlocks(ctx, req)
+	if status.Code(err) == codes.Unimplemented {
+		return c.executeBlocksOneByOne(ctx, blocks, prevStateRoot)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	stateRoots := make([]types.Hash, len(resp.StateRoots))
+	for i, stateRoot := range resp.StateRoots {
+		stateRoots[i] = stateRoot
+	}
+	if resp.Failed {
+		err := &blockError{message: resp.Error, code: int(resp.ErrorCode)}
+		return stateRoots, resp.MaxBytes, &types.BatchError{Index: len(stateRoots), Err: err}
+	}
+	return stateRoots, resp.MaxBytes, nil
+}
+
+func (c *Client) executeBlocksOneByOne(ctx context.Context, blocks []types.BatchBlock, prevStateRoot types.Hash) ([]types.Hash, uint64, error) {
+	var (
+		stateRoots = make([]types.Hash, 0, len(blocks))
+		maxBytes   uint64
+	)
+	for i, block := range blocks {
+		stateRoot, blockMaxBytes, err := c.ExecuteTxs(ctx, block.Txs, block.Height, block.Timestamp, prevStateRoot)
+		if err != nil {
+			return stateRoots, maxBytes, &types.BatchError{Index: i, Err: err}
+		}
+		stateRoots = append(stateRoots, stateRoot)
+		maxBytes = blockMaxBytes
+		prevStateRoot = stateRoot
+	}
+	return stateRoots, maxBytes, nil
+}
+
+// VerifyBlock executes a block and checks that it produces expectedRoot. It returns *types.StateRootMismatchError
+// if state roots differ. It fails with Unimplemented error if the executor doesn't support verification; use
+// execution.VerifyBlock to fall back to ExecuteTxs, which leaves the block pending even if state roots differ.
+func (c *Client) VerifyBlock(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot, expectedRoot types.Hash) error {
+	if !c.supports(execution.CapabilityVerification) {
+		return unsupported(execution.CapabilityVerification)
+	}
+	req := &pb.VerifyBlockRequest{
+		Txs:               make([][]byte, len(txs)),
+		BlockHeight:       blockHeight,
+		Timestamp:         timestamp.Unix(),
+		PrevStateRoot:     prevStateRoot,
+		ExpectedStateRoot: expectedRoot,
+	}
+	for i, tx := range txs {
+		req.Txs[i] = tx
+	}
+
+	resp, err := c.client.VerifyBlock(ctx, req)
+	if err != nil {
+		return err
+	}
+	if !resp.Valid {
+		return &types.StateRootMismatchError{Height: blockHeight, Expected: expectedRoot, Actual: resp.StateRoot}
+	}
+	return nil
+}
+
 // SetFinal marks a block at the given height as final.
 func (c *Client) SetFinal(ctx context.Context, blockHeight uint64) error {
 	_, err := c.client.SetFinal(ctx, &pb.SetFinalRequest{
@@ -112,3 +526,165 @@ func (c *Client) SetFinal(ctx context.Context, blockHeight uint64) error {
 	})
 	return err
 }
+
+// SetFinalRange marks blocks from fromHeight to toHeight, inclusive, as final in a single call. If the server
+// doesn't support range finalization, SetFinal is called for every height in the range.
+func (c *Client) SetFinalRange(ctx context.Context, fromHeight, toHeight uint64) error {
+	if !c.supports(execution.CapabilityRangeFinality) {
+		return c.setFinalOneByOne(ctx, fromHeight, toHeight)
+	}
+	_, err := c.client.SetFinalRange(ctx, &pb.SetFinalRangeRequest{
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		return c.setFinalOneByOne(ctx, fromHeight, toHeight)
+	}
+	return err
+}
+
+func (c *Client) setFinalOneByOne(ctx context.Context, fromHeight, toHeight uint64) error {
+	// hide RangeFinalizer of the client, so heights are finalized one by one
+	return execution.SetFinalRange(ctx, struct{ execution.Executor }{c}, fromHeight, toHeight)
+}
+
+// Prune removes historical state of blocks below retainHeight, except for checkpoints at multiples of keepEvery.
+func (c *Client) Prune(ctx context.Context, retainHeight, keepEvery uint64) error {
+	if !c.supports(execution.CapabilityPruning) {
+		return unsupported(execution.CapabilityPruning)
+	}
+	_, err := c.client.Prune(ctx, &pb.PruneRequest{
+		RetainHeight: retainHeight,
+		KeepEvery:    keepEvery,
+	})
+	return err
+}
+
+// StateRootAt returns the state root after the block at given height, and whether the block is finalized.
+func (c *Client) StateRootAt(ctx context.Context, height uint64) (types.Hash, bool, error) {
+	if !c.supports(execution.CapabilityStateQueries) {
+		return nil, false, unsupported(execution.CapabilityStateQueries)
+	}
+	resp, err := c.client.StateRootAt(ctx, &pb.StateRootAtRequest{
+		Height: height,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return resp.StateRoot, resp.Finalized, nil
+}
+
+// ChainStatus returns the latest executed and finalized heights.
+func (c *Client) ChainStatus(ctx context.Context) (*types.ChainStatus, error) {
+	if !c.supports(execution.CapabilityStateQueries) {
+		return nil, unsupported(execution.CapabilityStateQueries)
+	}
+	resp, err := c.client.ChainStatus(ctx, &pb.ChainStatusRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &types.ChainStatus{
+		LatestHeight:       resp.LatestHeight,
+		FinalizedHeight:    resp.FinalizedHeight,
+		FinalizedStateRoot: resp.FinalizedStateRoot,
+	}, nil
+}
+
+// ListSnapshots returns snapshots available on the server.
+func (c *Client) ListSnapshots(ctx context.Context) ([]types.Snapshot, error) {
+	if !c.supports(execution.CapabilitySnapshotProvider) {
+		return nil, unsupported(execution.CapabilitySnapshotProvider)
+	}
+	resp, err := c.client.ListSnapshots(ctx, &pb.ListSnapshotsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]types.Snapshot, len(resp.Snapshots))
+	for i, snapshot := range resp.Snapshots {
+		snapshots[i] = fromPbSnapshot(snapshot)
+	}
+	return snapshots, nil
+}
+
+// LoadSnapshotChunk returns a chunk of the snapshot. Use LoadSnapshotChunks to stream multiple chunks.
+func (c *Client) LoadSnapshotChunk(ctx context.Context, height uint64, format uint32, index uint32) ([]byte, error) {
+	var result []byte
+	err := c.loadSnapshotChunks(ctx, &pb.LoadSnapshotChunksRequest{
+		Height:     height,
+		Format:     format,
+		FirstIndex: index,
+		Count:      1,
+	}, func(_ uint32, chunk []byte) error {
+		result = chunk
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, types.ErrSnapshotNotFound
+	}
+	return result, nil
+}
+
+// LoadSnapshotChunks streams chunks of the snapshot starting from firstIndex in a single call, passing them
+// to fn in order. Streaming stops at the first error returned by fn.
+func (c *Client) LoadSnapshotChunks(ctx context.Context, height uint64, format uint32, firstIndex uint32, fn func(index uint32, chunk []byte) error) error {
+	return c.loadSnapshotChunks(ctx, &pb.LoadSnapshotChunksRequest{
+		Height:     height,
+		Format:     format,
+		FirstIndex: firstIndex,
+	}, fn)
+}
+
+func (c *Client) loadSnapshotChunks(ctx context.Context, req *pb.LoadSnapshotChunksRequest, fn func(index uint32, chunk []byte) error) error {
+	if !c.supports(execution.CapabilitySnapshotProvider) {
+		return unsupported(execution.CapabilitySnapshotProvider)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.client.LoadSnapshotChunks(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(resp.Index, resp.Chunk); err != nil {
+			return err
+		}
+	}
+}
+
+// OfferSnapshot starts restoration of the snapshot on the server.
+func (c *Client) OfferSnapshot(ctx context.Context, snapshot types.Snapshot) error {
+	if !c.supports(execution.CapabilitySnapshotRestorer) {
+		return unsupported(execution.CapabilitySnapshotRestorer)
+	}
+	_, err := c.client.OfferSnapshot(ctx, &pb.OfferSnapshotRequest{
+		Snapshot: toPbSnapshot(snapshot),
+	})
+	return err
+}
+
+// ApplySnapshotChunk applies a chunk of the offered snapshot on the server.
+func (c *Client) ApplySnapshotChunk(ctx context.Context, index uint32, chunk []byte) (bool, error) {
+	if !c.supports(execution.CapabilitySnapshotRestorer) {
+		return false, unsupported(execution.CapabilitySnapshotRestorer)
+	}
+	resp, err := c.client.ApplySnapshotChunk(ctx, &pb.ApplySnapshotChunkRequest{
+		Index: index,
+		Chunk: chunk,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.Done, nil
+}
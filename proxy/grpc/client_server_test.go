@@ -2,6 +2,9 @@ package grpc_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,11 +13,15 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 
+	"github.com/rollkit/go-execution"
 	"github.com/rollkit/go-execution/mocks"
 	grpcproxy "github.com/rollkit/go-execution/proxy/grpc"
+	"github.com/rollkit/go-execution/test"
 	"github.com/rollkit/go-execution/types"
 	pb "github.com/rollkit/go-execution/types/pb/execution"
 )
@@ -81,3 +88,246 @@ func TestClientServer(t *testing.T) {
 		mockExec.AssertExpectations(t)
 	})
 }
+
+func startExecutor(t *testing.T, exec execution.Executor) *grpcproxy.Client {
+	t.Helper()
+	config := testServerConfig()
+	handle, err := grpcproxy.StartServer(context.Background(), exec, config)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, handle.Stop()) })
+	return startClient(t, handle, config)
+}
+
+// optionalMethodTest tests an optional method using client of a server running exec.
+type optionalMethodTest func(t *testing.T, client *grpcproxy.Client, exec *test.DummyExecutor)
+
+// TestOptionalMethods tests methods of optional interfaces with an executor implementing them, and with
+// an executor implementing none of them, whose methods either fall back or fail with Unimplemented error.
+func TestOptionalMethods(t *testing.T) {
+	getTxsInfo := func(t *testing.T, client *grpcproxy.Client, exec *test.DummyExecutor) {
+		exec.InjectTx(types.Tx("tx1"))
+		exec.InjectTx(types.Tx("tx22"))
+		hash := sha256.Sum256([]byte("tx22"))
+
+		infos, err := client.GetTxsInfo(context.Background())
+		require.NoError(t, err)
+		require.Len(t, infos, 2)
+		assert.Equal(t, types.Tx("tx22"), infos[1].Tx)
+		assert.Equal(t, types.Hash(hash[:]), infos[1].Hash)
+		assert.Equal(t, uint64(4), infos[1].Size)
+
+		// plain method is unaffected
+		txs, err := client.GetTxs(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []types.Tx{types.Tx("tx1"), types.Tx("tx22")}, txs)
+	}
+
+	setFinalRange := func(t *testing.T, client *grpcproxy.Client, exec *test.DummyExecutor) {
+		ctx := context.Background()
+		stateRoot, _, err := client.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+		require.NoError(t, err)
+		for height := uint64(1); height <= 3; height++ {
+			stateRoot, _, err = client.ExecuteTxs(ctx, nil, height, time.Now(), stateRoot)
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, client.SetFinalRange(ctx, 1, 3))
+		assert.Equal(t, stateRoot, exec.GetStateRoot())
+
+		err = client.SetFinalRange(ctx, 3, 4)
+		assert.ErrorIs(t, err, types.ErrBlockNotFound)
+		err = client.SetFinalRange(ctx, 2, 1)
+		assert.ErrorIs(t, err, types.ErrInvalidBlockHeight)
+	}
+
+	for name, tc := range map[string]struct {
+		supported optionalMethodTest
+		// unsupported is run with executor without optional interfaces
+		unsupported optionalMethodTest
+	}{
+		"SimulateTxs": {
+			supported: func(t *testing.T, client *grpcproxy.Client, _ *test.DummyExecutor) {
+				ctx := context.Background()
+				txs := []types.Tx{types.Tx("tx1")}
+				timestamp := time.Now().Truncate(time.Second)
+				result, err := client.SimulateTxs(ctx, txs, 1, timestamp, types.Hash{1})
+				require.NoError(t, err)
+				assert.ErrorIs(t, client.SetFinal(ctx, 1), types.ErrBlockNotFound)
+
+				stateRoot, _, err := client.ExecuteTxs(ctx, txs, 1, timestamp, types.Hash{1})
+				require.NoError(t, err)
+				assert.Equal(t, stateRoot, result.StateRoot)
+				assert.NotZero(t, result.MaxBytes)
+			},
+			unsupported: func(t *testing.T, client *grpcproxy.Client, _ *test.DummyExecutor) {
+				_, err := client.SimulateTxs(context.Background(), nil, 1, time.Now(), types.Hash{1})
+				assert.Equal(t, codes.Unimplemented, status.Code(err))
+			},
+		},
+		// executor without TxInfoGetter falls back to GetTxs
+		"GetTxsInfo": {supported: getTxsInfo, unsupported: getTxsInfo},
+		"VerifyBlock": {
+			supported: func(t *testing.T, client *grpcproxy.Client, _ *test.DummyExecutor) {
+				ctx := context.Background()
+				txs := []types.Tx{types.Tx("tx1")}
+				timestamp := time.Now().Truncate(time.Second)
+				result, err := client.SimulateTxs(ctx, txs, 1, timestamp, types.Hash{1})
+				require.NoError(t, err)
+
+				err = client.VerifyBlock(ctx, txs, 1, timestamp, types.Hash{1}, types.Hash("invalid"))
+				require.ErrorIs(t, err, types.ErrStateRootMismatch)
+				var mismatch *types.StateRootMismatchError
+				require.True(t, errors.As(err, &mismatch))
+				assert.Equal(t, uint64(1), mismatch.Height)
+				assert.Equal(t, types.Hash("invalid"), mismatch.Expected)
+				assert.Equal(t, result.StateRoot, mismatch.Actual)
+				assert.Error(t, client.SetFinal(ctx, 1), "block must not be registered")
+
+				require.NoError(t, client.VerifyBlock(ctx, txs, 1, timestamp, types.Hash{1}, result.StateRoot))
+				assert.NoError(t, client.SetFinal(ctx, 1))
+			},
+			unsupported: func(t *testing.T, client *grpcproxy.Client, _ *test.DummyExecutor) {
+				ctx := context.Background()
+				timestamp := time.Now().Truncate(time.Second)
+
+				err := client.VerifyBlock(ctx, nil, 1, timestamp, types.Hash{1}, types.Hash("invalid"))
+				assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+				// caller falls back to ExecuteTxs, the block remains pending
+				err = execution.VerifyBlock(ctx, client, nil, 1, timestamp, types.Hash{1}, types.Hash("invalid"))
+				assert.ErrorIs(t, err, types.ErrStateRootMismatch)
+				assert.NoError(t, client.SetFinal(ctx, 1))
+			},
+		},
+		"Snapshots": {
+			supported: func(t *testing.T, source *grpcproxy.Client, sourceExec *test.DummyExecutor) {
+				targetExec := test.NewDummyExecutor()
+				target := startExecutor(t, targetExec)
+				ctx := context.Background()
+
+				stateRoot, _, err := source.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+				require.NoError(t, err)
+				for height := uint64(1); height <= 5; height++ {
+					stateRoot, _, err = source.ExecuteTxs(ctx, []types.Tx{types.Tx(fmt.Sprintf("tx-%d", height))}, height, time.Now(), stateRoot)
+					require.NoError(t, err)
+					require.NoError(t, source.SetFinal(ctx, height))
+				}
+
+				snapshots, err := source.ListSnapshots(ctx)
+				require.NoError(t, err)
+				require.NotEmpty(t, snapshots)
+				snapshot := snapshots[0]
+				assert.Equal(t, uint64(5), snapshot.Height)
+
+				// all chunks are streamed in a single call
+				var chunks [][]byte
+				err = source.LoadSnapshotChunks(ctx, snapshot.Height, snapshot.Format, 0, func(index uint32, chunk []byte) error {
+					assert.Equal(t, uint32(len(chunks)), index)
+					chunks = append(chunks, chunk)
+					return nil
+				})
+				require.NoError(t, err)
+				assert.Len(t, chunks, int(snapshot.Chunks))
+
+				require.NoError(t, execution.RestoreSnapshot(ctx, source, target, snapshot))
+				assert.Equal(t, sourceExec.GetStateRoot(), targetExec.GetStateRoot())
+				assert.Equal(t, stateRoot, targetExec.GetStateRoot())
+
+				err = target.OfferSnapshot(ctx, snapshot)
+				assert.ErrorIs(t, err, types.ErrSnapshotRejected)
+				_, err = source.LoadSnapshotChunk(ctx, 1, snapshot.Format, 0)
+				assert.ErrorIs(t, err, types.ErrSnapshotNotFound)
+			},
+			unsupported: func(t *testing.T, client *grpcproxy.Client, _ *test.DummyExecutor) {
+				ctx := context.Background()
+
+				_, err := client.ListSnapshots(ctx)
+				assert.Equal(t, codes.Unimplemented, status.Code(err))
+				_, err = client.LoadSnapshotChunk(ctx, 1, 1, 0)
+				assert.Equal(t, codes.Unimplemented, status.Code(err))
+				err = client.OfferSnapshot(ctx, types.Snapshot{Height: 1})
+				assert.Equal(t, codes.Unimplemented, status.Code(err))
+				_, err = client.ApplySnapshotChunk(ctx, 0, nil)
+				assert.Equal(t, codes.Unimplemented, status.Code(err))
+			},
+		},
+		"Prune": {
+			supported: func(t *testing.T, client *grpcproxy.Client, _ *test.DummyExecutor) {
+				ctx := context.Background()
+				stateRoot, _, err := client.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+				require.NoError(t, err)
+				for height := uint64(1); height <= 3; height++ {
+					stateRoot, _, err = client.ExecuteTxs(ctx, nil, height, time.Now(), stateRoot)
+					require.NoError(t, err)
+					require.NoError(t, client.SetFinal(ctx, height))
+				}
+
+				require.NoError(t, client.Prune(ctx, 3, 2))
+				_, _, err = client.StateRootAt(ctx, 1)
+				assert.ErrorIs(t, err, types.ErrBlockNotFound)
+				_, _, err = client.StateRootAt(ctx, 2)
+				assert.NoError(t, err, "checkpoint is kept")
+				assert.ErrorIs(t, client.Prune(ctx, 4, 0), types.ErrInvalidBlockHeight)
+			},
+			unsupported: func(t *testing.T, client *grpcproxy.Client, _ *test.DummyExecutor) {
+				assert.Equal(t, codes.Unimplemented, status.Code(client.Prune(context.Background(), 1, 0)))
+			},
+		},
+		"StateRootAt": {
+			supported: func(t *testing.T, client *grpcproxy.Client, _ *test.DummyExecutor) {
+				ctx := context.Background()
+				_, err := client.ChainStatus(ctx)
+				assert.ErrorIs(t, err, types.ErrChainNotInitialized)
+
+				genesisRoot, _, err := client.InitChain(ctx, time.Now().UTC(), 5, "test-chain")
+				require.NoError(t, err)
+				stateRoot, _, err := client.ExecuteTxs(ctx, nil, 5, time.Now(), genesisRoot)
+				require.NoError(t, err)
+
+				root, finalized, err := client.StateRootAt(ctx, 4)
+				require.NoError(t, err)
+				assert.Equal(t, genesisRoot, root)
+				assert.True(t, finalized)
+				root, finalized, err = client.StateRootAt(ctx, 5)
+				require.NoError(t, err)
+				assert.Equal(t, stateRoot, root)
+				assert.False(t, finalized)
+				_, _, err = client.StateRootAt(ctx, 6)
+				assert.ErrorIs(t, err, types.ErrBlockNotFound)
+
+				chainStatus, err := client.ChainStatus(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, &types.ChainStatus{LatestHeight: 5, FinalizedHeight: 4, FinalizedStateRoot: genesisRoot}, chainStatus)
+			},
+			unsupported: func(t *testing.T, client *grpcproxy.Client, _ *test.DummyExecutor) {
+				ctx := context.Background()
+				_, _, err := client.StateRootAt(ctx, 1)
+				assert.Equal(t, codes.Unimplemented, status.Code(err))
+				_, err = client.ChainStatus(ctx)
+				assert.Equal(t, codes.Unimplemented, status.Code(err))
+			},
+		},
+		"SetFinalRange": {
+			supported: setFinalRange,
+			unsupported: func(t *testing.T, client *grpcproxy.Client, exec *test.DummyExecutor) {
+				setFinalRange(t, client, exec)
+
+				// server reporting the capability, but not supporting the method
+				legacy := test.NewDummyExecutor()
+				legacyClient, err := startServiceClient(t, legacyServer{grpcproxy.NewServer(legacy, nil)})
+				require.NoError(t, err)
+				setFinalRange(t, legacyClient, legacy)
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			exec := test.NewDummyExecutor()
+			tc.supported(t, startExecutor(t, exec), exec)
+
+			t.Run("unsupported", func(t *testing.T) {
+				exec := test.NewDummyExecutor()
+				tc.unsupported(t, startExecutor(t, struct{ execution.Executor }{exec}), exec)
+			})
+		})
+	}
+}
@@ -1,18 +1,61 @@
 package grpc
 
-import "time"
+import (
+	"crypto/tls"
+	"log/slog"
+	"os"
+	"time"
+)
 
 // Config holds configuration settings for the gRPC proxy.
 type Config struct {
 	JWTSecret      []byte
 	DefaultTimeout time.Duration
+	// MaxRequestSize is the maximum size of a request received by the server. It also limits size of pages
+	// returned by paginated GetTxs. Default gRPC limit applies to requests, and pages are not limited, if it's 0.
 	MaxRequestSize int
+
+	// TLSCertFile and TLSKeyFile are paths to PEM encoded certificate and private key. Server uses them as
+	// its certificate, client presents them to the server for mutual TLS. Files are reloaded when they change.
+	TLSCertFile string
+	TLSKeyFile  string
+	// TLSCAFile is path to PEM encoded CA certificates. Server uses them to verify client certificates and requires
+	// clients to present one (mutual TLS), client uses them to verify server certificate.
+	TLSCAFile string
+	// TLSConfig is used as base TLS configuration. TLS is enabled if TLSConfig or any of the TLS files is set.
+	TLSConfig *tls.Config
+
+	// ListenAddress is the address used by StartServer. Addresses prefixed with "unix://" are Unix domain sockets.
+	ListenAddress string
+	// SocketPermissions are file permissions of Unix domain socket created by StartServer.
+	SocketPermissions os.FileMode
+	// ShutdownTimeout is the time given to pending calls to complete during graceful shutdown.
+	ShutdownTimeout time.Duration
+	// EnableReflection enables gRPC server reflection service.
+	EnableReflection bool
+	// HealthCheckInterval is the interval used to poll executor readiness in health checks.
+	HealthCheckInterval time.Duration
+	// Metrics is used to record metrics of calls handled by the server. Metrics are disabled if Metrics is nil.
+	Metrics MetricsRecorder
+
+	// Logger is used to log Execution API calls. Logging is disabled if Logger is nil.
+	Logger *slog.Logger
+	// LogLevel is the level used to log successful calls.
+	LogLevel slog.Level
+	// ErrorLogLevel is the level used to log failed calls.
+	ErrorLogLevel slog.Level
 }
 
 // DefaultConfig returns a Config instance populated with default settings.
 func DefaultConfig() *Config {
 	return &Config{
-		DefaultTimeout: time.Second,
-		MaxRequestSize: 1024 * 1024,
+		DefaultTimeout:      time.Second,
+		MaxRequestSize:      1024 * 1024,
+		ListenAddress:       "127.0.0.1:40041",
+		ShutdownTimeout:     5 * time.Second,
+		SocketPermissions:   0o660,
+		HealthCheckInterval: 100 * time.Millisecond,
+		LogLevel:            slog.LevelDebug,
+		ErrorLogLevel:       slog.LevelError,
 	}
 }
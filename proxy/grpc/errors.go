@@ -1,11 +1,177 @@
 package grpc
 
-// TODO(tzdybal): do we need this?
-//var (
-//	ErrUnknownPayload      = status.Error(codes.NotFound, "payload does not exist")
-//	ErrInvalidForkchoice   = status.Error(codes.InvalidArgument, "invalid forkchoice state")
-//	ErrInvalidPayloadAttrs = status.Error(codes.InvalidArgument, "invalid payload attributes")
-//	ErrTooLargeRequest     = status.Error(codes.ResourceExhausted, "request too large")
-//	ErrUnsupportedFork     = status.Error(codes.Unimplemented, "unsupported fork")
-//	ErrInvalidJWT          = status.Error(codes.Unauthenticated, "invalid JWT token")
-//)
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rollkit/go-execution/internal/errcode"
+	"github.com/rollkit/go-execution/types"
+)
+
+var (
+	// ErrInvalidJWT is returned when JWT authentication is enabled and the request is not properly authenticated
+	ErrInvalidJWT = status.Error(codes.Unauthenticated, "invalid JWT token")
+	// ErrIncompatibleProtocol is returned by Client.Start when the server speaks a different protocol version
+	ErrIncompatibleProtocol = errors.New("incompatible protocol version")
+)
+
+// errorDomain is the domain of errdetails.ErrorInfo carrying code of sentinel error (see errcode package).
+const errorDomain = "execution.rollkit.dev"
+
+// statusCodes maps sentinel errors from types package to gRPC status codes. Other errors are Unknown.
+var statusCodes = map[error]codes.Code{
+	types.ErrZeroInitialHeight:   codes.InvalidArgument,
+	types.ErrEmptyChainID:        codes.InvalidArgument,
+	types.ErrInvalidChainID:      codes.InvalidArgument,
+	types.ErrChainIDTooLong:      codes.InvalidArgument,
+	types.ErrFutureGenesisTime:   codes.InvalidArgument,
+	types.ErrChainNotInitialized: codes.FailedPrecondition,
+
+	types.ErrEmptyStateRoot:     codes.InvalidArgument,
+	types.ErrFutureBlockTime:    codes.InvalidArgument,
+	types.ErrInvalidBlockHeight: codes.InvalidArgument,
+	types.ErrTxTooLarge:         codes.InvalidArgument,
+	types.ErrEmptyTx:            codes.InvalidArgument,
+	types.ErrStateRootMismatch:  codes.FailedPrecondition,
+
+	types.ErrBlockNotFound:      codes.NotFound,
+	types.ErrBlockAlreadyExists: codes.AlreadyExists,
+	types.ErrNonSequentialBlock: codes.FailedPrecondition,
+
+	types.ErrTxAlreadyExists:  codes.AlreadyExists,
+	types.ErrTxPoolFull:       codes.ResourceExhausted,
+	types.ErrInvalidTxFormat:  codes.InvalidArgument,
+	types.ErrInvalidPageToken: codes.InvalidArgument,
+
+	types.ErrContextCanceled: codes.Canceled,
+	types.ErrContextTimeout:  codes.DeadlineExceeded,
+
+	types.ErrSnapshotNotFound:     codes.NotFound,
+	types.ErrSnapshotRejected:     codes.FailedPrecondition,
+	types.ErrInvalidSnapshotChunk: codes.InvalidArgument,
+}
+
+// toStatus converts error returned by executor to gRPC status error. Sentinel errors from types package are
+// mapped to matching status codes, with their code attached as errdetails.ErrorInfo. Status errors are
+// returned unchanged.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	code, ok := errcode.Code(err)
+	if !ok {
+		if ctxErr := status.FromContextError(err); ctxErr.Code() != codes.Unknown {
+			return ctxErr.Err()
+		}
+		return err
+	}
+	st := status.New(statusCodes[errcode.Err(code)], err.Error())
+	if detailed, detailsErr := st.WithDetails(&errdetails.ErrorInfo{Reason: strconv.Itoa(code), Domain: errorDomain}); detailsErr == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// statusError is a gRPC status error wrapping sentinel error from types package, so it can be matched with
+// errors.Is on the client side.
+type statusError struct {
+	status *status.Status
+	err    error
+}
+
+func (e *statusError) Error() string {
+	return e.status.Err().Error()
+}
+
+// GRPCStatus returns the status received from the server.
+func (e *statusError) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// Unwrap returns sentinel error from types package.
+func (e *statusError) Unwrap() error {
+	return e.err
+}
+
+// fromStatus converts status error received from the server to error wrapping sentinel error from types
+// package, if the status carries its code. Other errors are returned unchanged.
+func fromStatus(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || err == nil {
+		return err
+	}
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.Domain != errorDomain {
+			continue
+		}
+		code, convErr := strconv.Atoi(info.Reason)
+		if sentinel := errcode.Err(code); convErr == nil && sentinel != nil {
+			return &statusError{status: st, err: sentinel}
+		}
+	}
+	return err
+}
+
+// ErrorUnaryServerInterceptor returns a unary server interceptor that converts errors returned by the executor
+// to gRPC status errors, so clients can match sentinel errors from types package. Server converts errors of
+// its handlers itself; the interceptor covers errors of other interceptors and services.
+func ErrorUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		return resp, toStatus(err)
+	}
+}
+
+// ErrorStreamServerInterceptor returns a stream server interceptor that converts errors returned by the executor
+// to gRPC status errors, so clients can match sentinel errors from types package.
+func ErrorStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return toStatus(handler(srv, ss))
+	}
+}
+
+func errorUnaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return fromStatus(invoker(ctx, method, req, reply, cc, opts...))
+}
+
+func errorStreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	return errorClientStream{stream}, nil
+}
+
+// errorClientStream converts errors of received messages with fromStatus.
+type errorClientStream struct {
+	grpc.ClientStream
+}
+
+func (s errorClientStream) RecvMsg(m any) error {
+	return fromStatus(s.ClientStream.RecvMsg(m))
+}
+
+// blockError is the error of a failed block reported by ExecuteBlocks response.
+type blockError struct {
+	message string
+	code    int
+}
+
+func (e *blockError) Error() string {
+	return e.message
+}
+
+// Unwrap returns sentinel error from types package corresponding to the error code, if any.
+func (e *blockError) Unwrap() error {
+	return errcode.Err(e.code)
+}
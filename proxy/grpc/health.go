@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/rollkit/go-execution"
+)
+
+// ServiceName is the fully qualified name of the Execution API gRPC service.
+const ServiceName = "execution.ExecutionService"
+
+// HealthServer implements the standard gRPC health checking protocol (grpc.health.v1).
+// Serving status is driven by the execution.HealthChecker implementation of the wrapped Executor;
+// executors that don't implement it are always reported as SERVING.
+type HealthServer struct {
+	healthpb.UnimplementedHealthServer
+	exec   execution.Executor
+	config *Config
+}
+
+// NewHealthServer creates a new health checking service for the given execution client and configuration.
+func NewHealthServer(exec execution.Executor, config *Config) *HealthServer {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	return &HealthServer{
+		exec:   exec,
+		config: config,
+	}
+}
+
+// Check handles Check method call from gRPC health checking API.
+func (h *HealthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if !isKnownService(req.Service) {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.Service)
+	}
+	return &healthpb.HealthCheckResponse{Status: h.status(ctx)}, nil
+}
+
+// Watch handles Watch method call from gRPC health checking API.
+// Status of the executor is polled every Config.HealthCheckInterval and sent to the client whenever it changes.
+func (h *HealthServer) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	ctx := stream.Context()
+	if !isKnownService(req.Service) {
+		// as defined by the health checking protocol, unknown services are not an error for Watch
+		return stream.Send(&healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVICE_UNKNOWN})
+	}
+
+	ticker := time.NewTicker(h.checkInterval())
+	defer ticker.Stop()
+
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		if current := h.status(ctx); current != last {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: current}); err != nil {
+				return err
+			}
+			last = current
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *HealthServer) status(ctx context.Context) healthpb.HealthCheckResponse_ServingStatus {
+	checker, ok := h.exec.(execution.HealthChecker)
+	if !ok {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	if err := checker.CheckHealth(ctx); err != nil {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	return healthpb.HealthCheckResponse_SERVING
+}
+
+func (h *HealthServer) checkInterval() time.Duration {
+	if h.config.HealthCheckInterval > 0 {
+		return h.config.HealthCheckInterval
+	}
+	return DefaultConfig().HealthCheckInterval
+}
+
+func isKnownService(service string) bool {
+	return service == "" || service == ServiceName
+}
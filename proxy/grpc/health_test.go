@@ -0,0 +1,135 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/rollkit/go-execution/mocks"
+	grpcproxy "github.com/rollkit/go-execution/proxy/grpc"
+	"github.com/rollkit/go-execution/test"
+	pb "github.com/rollkit/go-execution/types/pb/execution"
+)
+
+func startHealthServer(t *testing.T, exec *test.DummyExecutor, config *grpcproxy.Config) *grpcproxy.Client {
+	t.Helper()
+
+	listener := bufconn.Listen(bufSize)
+	s := grpc.NewServer()
+	pb.RegisterExecutionServiceServer(s, grpcproxy.NewServer(exec, config))
+	healthpb.RegisterHealthServer(s, grpcproxy.NewHealthServer(exec, config))
+	go func() {
+		_ = s.Serve(listener)
+	}()
+	t.Cleanup(s.Stop)
+
+	client := grpcproxy.NewClient()
+	client.SetConfig(config)
+	err := client.Start("passthrough://bufnet",
+		grpc.WithContextDialer(dialer(listener)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Stop() })
+
+	return client
+}
+
+func TestHealthServer(t *testing.T) {
+	config := grpcproxy.DefaultConfig()
+	config.HealthCheckInterval = 10 * time.Millisecond
+	exec := test.NewDummyExecutor()
+	server := grpcproxy.NewHealthServer(exec, config)
+
+	ctx := context.Background()
+
+	resp, err := server.Check(ctx, &healthpb.HealthCheckRequest{Service: grpcproxy.ServiceName})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.Status)
+
+	_, _, err = exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+
+	resp, err = server.Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+
+	_, err = server.Check(ctx, &healthpb.HealthCheckRequest{Service: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestHealthServerWithoutHealthChecker(t *testing.T) {
+	server := grpcproxy.NewHealthServer(mocks.NewMockExecutor(t), nil)
+
+	resp, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+}
+
+func TestClientWaitReady(t *testing.T) {
+	config := grpcproxy.DefaultConfig()
+	config.HealthCheckInterval = 10 * time.Millisecond
+	exec := test.NewDummyExecutor()
+	client := startHealthServer(t, exec, config)
+
+	// executor is not initialized, so WaitReady must time out
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := client.WaitReady(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_, _, _ = exec.InitChain(context.Background(), time.Now().UTC(), 1, "test-chain")
+	}()
+
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel2()
+	require.NoError(t, client.WaitReady(ctx2))
+}
+
+func TestHealthWatch(t *testing.T) {
+	config := grpcproxy.DefaultConfig()
+	config.HealthCheckInterval = 10 * time.Millisecond
+	exec := test.NewDummyExecutor()
+
+	listener := bufconn.Listen(bufSize)
+	s := grpc.NewServer()
+	healthpb.RegisterHealthServer(s, grpcproxy.NewHealthServer(exec, config))
+	go func() {
+		_ = s.Serve(listener)
+	}()
+	defer s.Stop()
+
+	conn, err := grpc.NewClient("passthrough://bufnet",
+		grpc.WithContextDialer(dialer(listener)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	stream, err := healthpb.NewHealthClient(conn).Watch(ctx, &healthpb.HealthCheckRequest{Service: grpcproxy.ServiceName})
+	require.NoError(t, err)
+
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.Status)
+
+	_, _, err = exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+}
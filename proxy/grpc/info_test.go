@@ -0,0 +1,198 @@
+package grpc_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/rollkit/go-execution"
+	grpcproxy "github.com/rollkit/go-execution/proxy/grpc"
+	"github.com/rollkit/go-execution/test"
+	"github.com/rollkit/go-execution/types"
+	pb "github.com/rollkit/go-execution/types/pb/execution"
+)
+
+// startServiceClient serves given service implementation and returns result of starting a client connected to it.
+func startServiceClient(t *testing.T, server pb.ExecutionServiceServer) (*grpcproxy.Client, error) {
+	t.Helper()
+
+	listener := bufconn.Listen(bufSize)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(grpcproxy.ErrorUnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(grpcproxy.ErrorStreamServerInterceptor()),
+	)
+	pb.RegisterExecutionServiceServer(s, server)
+	go func() {
+		_ = s.Serve(listener)
+	}()
+	t.Cleanup(s.Stop)
+
+	client := grpcproxy.NewClient()
+	err := client.Start("passthrough://bufnet",
+		grpc.WithContextDialer(dialer(listener)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	t.Cleanup(func() { _ = client.Stop() })
+	return client, err
+}
+
+// versionedServer reports given protocol version, or doesn't support Info if the version is 0.
+type versionedServer struct {
+	pb.ExecutionServiceServer
+	version uint32
+}
+
+func (s versionedServer) Info(ctx context.Context, req *pb.InfoRequest) (*pb.InfoResponse, error) {
+	if s.version == 0 {
+		return nil, status.Error(codes.Unimplemented, "method Info not implemented")
+	}
+	resp, err := s.ExecutionServiceServer.Info(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp.ProtocolVersion = s.version
+	return resp, nil
+}
+
+func TestInfo(t *testing.T) {
+	client := startExecutor(t, test.NewDummyExecutor())
+	info, err := client.Info(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "dummy", info.Name)
+	assert.Equal(t, "v1.0.0", info.Version)
+	assert.Equal(t, uint32(grpcproxy.ProtocolVersion), info.ProtocolVersion)
+	assert.Equal(t, execution.Capabilities(test.NewDummyExecutor()), info.Capabilities)
+	assert.True(t, info.HasCapability(execution.CapabilitySnapshotProvider))
+	assert.Equal(t, info, client.ServerInfo(), "server info is obtained by Start")
+
+	// executor without optional interfaces
+	client = startExecutor(t, struct{ execution.Executor }{test.NewDummyExecutor()})
+	info, err = client.Info(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, info.Capabilities)
+	assert.Contains(t, info.Name, "struct")
+	assert.Empty(t, info.Version)
+}
+
+func TestProtocolVersion(t *testing.T) {
+	newServer := func() pb.ExecutionServiceServer {
+		return grpcproxy.NewServer(test.NewDummyExecutor(), nil)
+	}
+
+	_, err := startServiceClient(t, versionedServer{newServer(), grpcproxy.ProtocolVersion + 1})
+	assert.ErrorIs(t, err, grpcproxy.ErrIncompatibleProtocol)
+
+	// servers predating Info are accepted
+	client, err := startServiceClient(t, versionedServer{newServer(), 0})
+	require.NoError(t, err)
+	require.NotNil(t, client.ServerInfo())
+	assert.Empty(t, client.ServerInfo().Name)
+	_, err = client.GetTxs(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestProtocolVersionCheckedBeforeFirstCall(t *testing.T) {
+	// server is not running when the client starts
+	config := testServerConfig()
+	handle, err := grpcproxy.StartServer(context.Background(), test.NewDummyExecutor(), config)
+	require.NoError(t, err)
+	addr := handle.Addr().String()
+	require.NoError(t, handle.Stop())
+
+	client := grpcproxy.NewClient()
+	client.SetConfig(config)
+	require.NoError(t, client.Start(addr, grpc.WithTransportCredentials(insecure.NewCredentials())))
+	defer func() { _ = client.Stop() }()
+	assert.Nil(t, client.ServerInfo())
+
+	config.ListenAddress = addr
+	handle, err = grpcproxy.StartServer(context.Background(), test.NewDummyExecutor(), config)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, handle.Stop()) }()
+
+	// connection is retried with backoff, version check is retried after a delay
+	assert.Eventually(t, func() bool {
+		_, err := client.GetTxs(context.Background())
+		return err == nil && client.ServerInfo() != nil
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+// flakyInfoServer fails the first Info call with Unavailable error, and blocks Info calls until release is closed.
+type flakyInfoServer struct {
+	versionedServer
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (s *flakyInfoServer) Info(ctx context.Context, req *pb.InfoRequest) (*pb.InfoResponse, error) {
+	if s.calls.Add(1) == 1 {
+		return nil, status.Error(codes.Unavailable, "not yet")
+	}
+	select {
+	case <-s.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return s.versionedServer.Info(ctx, req)
+}
+
+func TestProtocolVersionCheckedBeforeFirstStream(t *testing.T) {
+	server := &flakyInfoServer{
+		versionedServer: versionedServer{grpcproxy.NewServer(test.NewDummyExecutor(), nil), grpcproxy.ProtocolVersion + 1},
+		release:         make(chan struct{}),
+	}
+	client, err := startServiceClient(t, server)
+	require.NoError(t, err)
+	assert.Nil(t, client.ServerInfo())
+
+	// the check isn't retried right after it failed
+	_, err = client.GetTxs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), server.calls.Load())
+
+	errs := make(chan error, 1)
+	go func() {
+		time.Sleep(1100 * time.Millisecond)
+		errs <- client.LoadSnapshotChunks(context.Background(), 1, 1, 0, func(uint32, []byte) error { return nil })
+	}()
+	// server info doesn't wait for the check in progress
+	require.Eventually(t, func() bool { return server.calls.Load() == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.Nil(t, client.ServerInfo())
+	_, known := client.Capabilities()
+	assert.False(t, known)
+
+	close(server.release)
+	assert.ErrorIs(t, <-errs, grpcproxy.ErrIncompatibleProtocol)
+	_, err = client.GetTxs(context.Background())
+	assert.ErrorIs(t, err, grpcproxy.ErrIncompatibleProtocol)
+	assert.Equal(t, int32(2), server.calls.Load())
+}
+
+func TestCapabilities(t *testing.T) {
+	client := startExecutor(t, test.NewDummyExecutor())
+	assert.Equal(t, execution.Capabilities(test.NewDummyExecutor()), execution.Capabilities(client))
+
+	// executor without optional interfaces
+	client = startExecutor(t, struct{ execution.Executor }{test.NewDummyExecutor()})
+	assert.Empty(t, execution.Capabilities(client))
+	_, err := client.SimulateTxs(context.Background(), nil, 1, time.Now(), types.Hash{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	_, err = client.ChainStatus(context.Background())
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	// capabilities of servers predating Info are unknown
+	client, err = startServiceClient(t, versionedServer{grpcproxy.NewServer(test.NewDummyExecutor(), nil), 0})
+	require.NoError(t, err)
+	_, known := client.Capabilities()
+	assert.False(t, known)
+	assert.Contains(t, execution.Capabilities(client), execution.CapabilitySimulation)
+}
@@ -0,0 +1,116 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MetricsRecorder records metrics of Execution API calls handled by the server.
+type MetricsRecorder interface {
+	// RecordCall is invoked after every call, with full gRPC method name, resulting status code and call duration.
+	RecordCall(method string, code codes.Code, duration time.Duration)
+}
+
+// AuthUnaryServerInterceptor returns a unary server interceptor that requires a valid JWT for all Execution API
+// calls if config.JWTSecret is set. Other services (like health checking) are not authenticated.
+func AuthUnaryServerInterceptor(config *Config) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := authenticate(ctx, config, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthStreamServerInterceptor returns a stream server interceptor that requires a valid JWT for all Execution API
+// calls if config.JWTSecret is set. Other services (like health checking) are not authenticated.
+func AuthStreamServerInterceptor(config *Config) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authenticate(ss.Context(), config, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func authenticate(ctx context.Context, config *Config, method string) error {
+	if config == nil || config.JWTSecret == nil || !isExecutionMethod(method) {
+		return nil
+	}
+	return verifyJWT(ctx, config.JWTSecret)
+}
+
+func isExecutionMethod(method string) bool {
+	return strings.HasPrefix(method, "/"+ServiceName+"/")
+}
+
+// RecoveryUnaryServerInterceptor returns a unary server interceptor that recovers from panics in handlers
+// and converts them into Internal errors. Panics are logged if config.Logger is set.
+func RecoveryUnaryServerInterceptor(config *Config) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(ctx, config, info.FullMethod, r)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// RecoveryStreamServerInterceptor returns a stream server interceptor that recovers from panics in handlers
+// and converts them into Internal errors. Panics are logged if config.Logger is set.
+func RecoveryStreamServerInterceptor(config *Config) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(ss.Context(), config, info.FullMethod, r)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+func recovered(ctx context.Context, config *Config, method string, r any) error {
+	if config != nil && config.Logger != nil {
+		config.Logger.LogAttrs(ctx, slog.LevelError, "recovered from panic",
+			slog.String("method", method),
+			slog.Any("panic", r),
+			slog.String("stack", string(debug.Stack())),
+		)
+	}
+	return status.Errorf(codes.Internal, "panic in %s", method)
+}
+
+// MetricsUnaryServerInterceptor returns a unary server interceptor that reports every call to config.Metrics.
+func MetricsUnaryServerInterceptor(config *Config) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if config == nil || config.Metrics == nil {
+			return handler(ctx, req)
+		}
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		config.Metrics.RecordCall(info.FullMethod, status.Code(err), time.Since(start))
+		return resp, err
+	}
+}
+
+// MetricsStreamServerInterceptor returns a stream server interceptor that reports every streaming call to
+// config.Metrics once the stream ends.
+func MetricsStreamServerInterceptor(config *Config) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if config == nil || config.Metrics == nil {
+			return handler(srv, ss)
+		}
+		start := time.Now()
+		err := handler(srv, ss)
+		config.Metrics.RecordCall(info.FullMethod, status.Code(err), time.Since(start))
+		return err
+	}
+}
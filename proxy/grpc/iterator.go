@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/rollkit/go-execution/types"
+)
+
+// TxIterator iterates over all transactions in the execution client's mempool, fetching them page by page
+// with paginated GetTxs.
+//
+//	it := client.IterateTxs(ctx, 1000)
+//	for it.Next() {
+//		tx := it.Tx()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type TxIterator struct {
+	ctx      context.Context
+	client   *Client
+	pageSize uint64
+
+	page      []types.Tx
+	nextToken []byte
+	lastPage  bool
+	tx        types.Tx
+	err       error
+}
+
+// IterateTxs returns an iterator over all transactions in the execution client's mempool. Pages have up to
+// pageSize transactions; 0 means MaxPageSize.
+func (c *Client) IterateTxs(ctx context.Context, pageSize uint64) *TxIterator {
+	return &TxIterator{
+		ctx:      ctx,
+		client:   c,
+		pageSize: pageSize,
+	}
+}
+
+// Next advances the iterator to the next transaction, fetching next page if needed. It returns false when
+// there are no more transactions or an error occurred.
+func (it *TxIterator) Next() bool {
+	for len(it.page) == 0 {
+		if it.lastPage || it.err != nil {
+			return false
+		}
+		it.page, it.nextToken, it.err = it.client.GetTxsPage(it.ctx, it.nextToken, types.TxLimits{MaxTxs: it.pageSize})
+		it.lastPage = len(it.nextToken) == 0
+	}
+	it.tx, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Tx returns the current transaction.
+func (it *TxIterator) Tx() types.Tx {
+	return it.tx
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *TxIterator) Err() error {
+	return it.err
+}
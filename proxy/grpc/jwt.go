@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/rollkit/go-execution/internal/jwt"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// verifyJWT checks bearer token attached to incoming call.
+func verifyJWT(ctx context.Context, secret []byte) error {
+	token, ok := tokenFromContext(ctx)
+	if !ok {
+		return ErrInvalidJWT
+	}
+	if err := jwt.Verify(secret, token, time.Now()); err != nil {
+		return ErrInvalidJWT
+	}
+	return nil
+}
+
+// tokenFromContext extracts bearer token from incoming gRPC metadata.
+func tokenFromContext(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	for _, value := range md.Get(authorizationHeader) {
+		if strings.HasPrefix(value, bearerPrefix) {
+			return strings.TrimPrefix(value, bearerPrefix), true
+		}
+	}
+	return "", false
+}
+
+// jwtCredentials attaches freshly generated JWT to every call made by the client.
+type jwtCredentials struct {
+	secret []byte
+}
+
+var _ credentials.PerRPCCredentials = jwtCredentials{}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials.
+func (c jwtCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	token, err := jwt.New(c.secret, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{authorizationHeader: bearerPrefix + token}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials.
+func (c jwtCredentials) RequireTransportSecurity() bool {
+	return false
+}
@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+)
+
+// ErrSocketInUse is returned when Unix domain socket is already used by a running server.
+var ErrSocketInUse = errors.New("unix socket is already in use")
+
+// UnixSocketPath returns path of Unix domain socket if address uses "unix://" (or "unix:") scheme.
+func UnixSocketPath(address string) (string, bool) {
+	if path, ok := strings.CutPrefix(address, "unix://"); ok {
+		return path, true
+	}
+	if path, ok := strings.CutPrefix(address, "unix:"); ok {
+		return path, true
+	}
+	return "", false
+}
+
+// Listen announces on the given address. Addresses prefixed with "unix://" are Unix domain sockets,
+// all other addresses are TCP addresses.
+//
+// Stale socket file left by a previous (crashed) server is removed before listening. Socket file permissions
+// are set to perm, unless perm is zero.
+func Listen(address string, perm os.FileMode) (net.Listener, error) {
+	path, ok := UnixSocketPath(address)
+	if !ok {
+		return net.Listen("tcp", address)
+	}
+
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if perm != 0 {
+		if err := os.Chmod(path, perm); err != nil {
+			_ = listener.Close()
+			return nil, fmt.Errorf("failed to set socket permissions: %w", err)
+		}
+	}
+	return listener, nil
+}
+
+// removeStaleSocket removes socket file if no server is accepting connections on it.
+// Files that are not sockets are never removed.
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%q exists and is not a unix socket", path)
+	}
+
+	conn, err := net.DialTimeout("unix", path, time.Second)
+	if err == nil {
+		_ = conn.Close()
+		return fmt.Errorf("%w: %q", ErrSocketInUse, path)
+	}
+	return os.Remove(path)
+}
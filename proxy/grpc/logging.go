@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/rollkit/go-execution/types/pb/execution"
+)
+
+// LoggingUnaryServerInterceptor returns a unary server interceptor that logs every Execution API call
+// handled by the server using the logger and levels from config.
+//
+// Only call metadata (method, height, number of transactions, duration and status code) is logged.
+// Request headers (including JWT tokens) and transaction contents are never logged.
+func LoggingUnaryServerInterceptor(config *Config) grpc.UnaryServerInterceptor {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if config.Logger == nil {
+			return handler(ctx, req)
+		}
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logCall(ctx, config, "server", info.FullMethod, req, resp, time.Since(start), err)
+		return resp, err
+	}
+}
+
+// LoggingStreamServerInterceptor returns a stream server interceptor that logs every streaming Execution API
+// call handled by the server once the stream ends, like LoggingUnaryServerInterceptor. The first message
+// received from the client is logged as the request.
+func LoggingStreamServerInterceptor(config *Config) grpc.StreamServerInterceptor {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if config.Logger == nil {
+			return handler(srv, ss)
+		}
+		start := time.Now()
+		stream := &loggedServerStream{ServerStream: ss}
+		err := handler(srv, stream)
+		logCall(ss.Context(), config, "server", info.FullMethod, stream.req, nil, time.Since(start), err)
+		return err
+	}
+}
+
+// loggedServerStream records the first message received from the client.
+type loggedServerStream struct {
+	grpc.ServerStream
+	req any
+}
+
+func (s *loggedServerStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.req == nil {
+		s.req = m
+	}
+	return err
+}
+
+// LoggingUnaryClientInterceptor returns a unary client interceptor that logs every Execution API call
+// made by the client using the logger and levels from config.
+//
+// Only call metadata (method, height, number of transactions, duration and status code) is logged.
+// Request headers (including JWT tokens) and transaction contents are never logged.
+func LoggingUnaryClientInterceptor(config *Config) grpc.UnaryClientInterceptor {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if config.Logger == nil {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		logCall(ctx, config, "client", method, req, reply, time.Since(start), err)
+		return err
+	}
+}
+
+func logCall(ctx context.Context, config *Config, side, method string, req, resp any, duration time.Duration, err error) {
+	level := config.LogLevel
+	if err != nil {
+		level = config.ErrorLogLevel
+	}
+	if !config.Logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("side", side),
+		slog.String("method", method),
+	}
+	attrs = append(attrs, requestAttrs(req)...)
+	if err == nil {
+		attrs = append(attrs, responseAttrs(resp)...)
+	}
+	attrs = append(attrs,
+		slog.Duration("duration", duration),
+		slog.String("code", status.Code(err).String()),
+	)
+	if err != nil {
+		attrs = append(attrs, slog.String("error", status.Convert(err).Message()))
+	}
+
+	config.Logger.LogAttrs(ctx, level, "execution API call", attrs...)
+}
+
+// requestAttrs extracts loggable, non-sensitive attributes from Execution API requests.
+func requestAttrs(req any) []slog.Attr {
+	switch r := req.(type) {
+	case *pb.InitChainRequest:
+		return []slog.Attr{slog.Uint64("height", r.InitialHeight), slog.String("chain_id", r.ChainId)}
+	case *pb.ExecuteTxsRequest:
+		return []slog.Attr{slog.Uint64("height", r.BlockHeight), slog.Int("tx_count", len(r.Txs))}
+	case *pb.SetFinalRequest:
+		return []slog.Attr{slog.Uint64("height", r.BlockHeight)}
+	case *pb.SetFinalRangeRequest:
+		return []slog.Attr{slog.Uint64("from_height", r.FromHeight), slog.Uint64("to_height", r.ToHeight)}
+	case *pb.ExecuteBlocksRequest:
+		return []slog.Attr{slog.Int("block_count", len(r.Blocks))}
+	case *pb.VerifyBlockRequest:
+		return []slog.Attr{slog.Uint64("height", r.BlockHeight), slog.Int("tx_count", len(r.Txs))}
+	case *pb.SimulateTxsRequest:
+		return []slog.Attr{slog.Uint64("height", r.BlockHeight), slog.Int("tx_count", len(r.Txs))}
+	case *pb.StateRootAtRequest:
+		return []slog.Attr{slog.Uint64("height", r.Height)}
+	case *pb.PruneRequest:
+		return []slog.Attr{slog.Uint64("retain_height", r.RetainHeight), slog.Uint64("keep_every", r.KeepEvery)}
+	case *pb.OfferSnapshotRequest:
+		return []slog.Attr{slog.Uint64("height", r.Snapshot.GetHeight()), slog.Uint64("chunks", uint64(r.Snapshot.GetChunks()))}
+	case *pb.LoadSnapshotChunksRequest:
+		return []slog.Attr{slog.Uint64("height", r.Height), slog.Uint64("first_index", uint64(r.FirstIndex))}
+	case *pb.ApplySnapshotChunkRequest:
+		return []slog.Attr{slog.Uint64("index", uint64(r.Index))}
+	}
+	return nil
+}
+
+// responseAttrs extracts loggable, non-sensitive attributes from Execution API responses.
+func responseAttrs(resp any) []slog.Attr {
+	switch r := resp.(type) {
+	case *pb.GetTxsResponse:
+		return []slog.Attr{slog.Int("tx_count", len(r.Txs)+len(r.TxInfos))}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package grpc_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	grpcproxy "github.com/rollkit/go-execution/proxy/grpc"
+	"github.com/rollkit/go-execution/test"
+	"github.com/rollkit/go-execution/types"
+	pb "github.com/rollkit/go-execution/types/pb/execution"
+)
+
+// syncBuffer is a bytes.Buffer safe for concurrent use by server and client loggers.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestLoggingInterceptors(t *testing.T) {
+	var serverLog, clientLog syncBuffer
+
+	secret := []byte("very-secret-jwt-key")
+	serverConfig := grpcproxy.DefaultConfig()
+	serverConfig.JWTSecret = secret
+	serverConfig.Logger = slog.New(slog.NewTextHandler(&serverLog, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	clientConfig := grpcproxy.DefaultConfig()
+	clientConfig.Logger = slog.New(slog.NewTextHandler(&clientLog, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	exec := test.NewDummyExecutor()
+	listener := bufconn.Listen(bufSize)
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(grpcproxy.LoggingUnaryServerInterceptor(serverConfig)))
+	pb.RegisterExecutionServiceServer(s, grpcproxy.NewServer(exec, serverConfig))
+	go func() {
+		_ = s.Serve(listener)
+	}()
+	defer s.Stop()
+
+	client := grpcproxy.NewClient()
+	client.SetConfig(clientConfig)
+	err := client.Start("passthrough://bufnet",
+		grpc.WithContextDialer(dialer(listener)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer func() { _ = client.Stop() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+string(secret))
+
+	exec.InjectTx(types.Tx("tx1"))
+	_, err = client.GetTxs(ctx)
+	require.NoError(t, err)
+
+	_, _, err = client.ExecuteTxs(ctx, []types.Tx{types.Tx("tx1"), types.Tx("tx2")}, 7, time.Now(), types.Hash{1, 2, 3})
+	require.NoError(t, err)
+
+	err = client.SetFinal(ctx, 42)
+	require.Error(t, err)
+
+	for _, out := range []string{serverLog.String(), clientLog.String()} {
+		assert.Contains(t, out, "method=/execution.ExecutionService/GetTxs")
+		assert.Contains(t, out, "method=/execution.ExecutionService/ExecuteTxs height=7 tx_count=2")
+		assert.Contains(t, out, "level=ERROR")
+		assert.Contains(t, out, "height=42")
+		assert.Contains(t, out, "code=NotFound")
+		assert.Contains(t, out, "duration=")
+		assert.NotContains(t, out, string(secret))
+	}
+}
+
+func TestLoggingInterceptorLevels(t *testing.T) {
+	var out syncBuffer
+	config := grpcproxy.DefaultConfig()
+	config.Logger = slog.New(slog.NewTextHandler(&out, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	interceptor := grpcproxy.LoggingUnaryServerInterceptor(config)
+	info := &grpc.UnaryServerInfo{FullMethod: "/execution.ExecutionService/SetFinal"}
+	handler := func(context.Context, any) (any, error) { return &pb.SetFinalResponse{}, nil }
+
+	// successful calls are logged at debug level by default
+	_, err := interceptor(context.Background(), &pb.SetFinalRequest{BlockHeight: 1}, info, handler)
+	require.NoError(t, err)
+	assert.Empty(t, out.String())
+
+	config.LogLevel = slog.LevelInfo
+	_, err = interceptor(context.Background(), &pb.SetFinalRequest{BlockHeight: 1}, info, handler)
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), "level=INFO")
+	assert.Contains(t, out.String(), "code=OK")
+}
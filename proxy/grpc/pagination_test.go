@@ -0,0 +1,122 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rollkit/go-execution"
+	"github.com/rollkit/go-execution/mempool"
+	grpcproxy "github.com/rollkit/go-execution/proxy/grpc"
+	"github.com/rollkit/go-execution/test"
+	"github.com/rollkit/go-execution/types"
+)
+
+// injectTxs fills mempool of exec with 1KiB transactions, with more than config.MaxRequestSize bytes in total.
+func injectTxs(exec *test.DummyExecutor, config *grpcproxy.Config) []types.Tx {
+	var txs []types.Tx
+	for size := 0; size <= 2*config.MaxRequestSize; size += 1024 {
+		tx := make(types.Tx, 1024)
+		copy(tx, fmt.Sprintf("tx-%d", len(txs)))
+		exec.InjectTx(tx)
+		txs = append(txs, tx)
+	}
+	return txs
+}
+
+func TestGetTxsPagination(t *testing.T) {
+	// mempool keeps page tokens valid after transactions are removed
+	exec := test.NewDummyExecutorWithMempool(mempool.New(nil))
+	config := testServerConfig()
+	handle, err := grpcproxy.StartServer(context.Background(), exec, config)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, handle.Stop()) }()
+	client := startClient(t, handle, config)
+	ctx := context.Background()
+
+	expected := injectTxs(exec, config)
+
+	var (
+		all   []types.Tx
+		pages int
+		token []byte
+	)
+	for {
+		page, next, err := client.GetTxsPage(ctx, token, types.TxLimits{})
+		require.NoError(t, err)
+		size := 0
+		for _, tx := range page {
+			size += len(tx)
+		}
+		assert.LessOrEqual(t, size, config.MaxRequestSize)
+		all = append(all, page...)
+		pages++
+		if len(next) == 0 {
+			break
+		}
+		token = next
+	}
+	assert.Greater(t, pages, 1)
+	assert.Equal(t, expected, all)
+
+	t.Run("iterator", func(t *testing.T) {
+		it := client.IterateTxs(ctx, 100)
+		var txs []types.Tx
+		for it.Next() {
+			txs = append(txs, it.Tx())
+		}
+		require.NoError(t, it.Err())
+		assert.Equal(t, expected, txs)
+	})
+
+	t.Run("removed transactions", func(t *testing.T) {
+		page, next, err := client.GetTxsPage(ctx, nil, types.TxLimits{MaxTxs: 10})
+		require.NoError(t, err)
+		require.Len(t, page, 10)
+
+		// last transaction of the page is included in a block; token remains valid
+		_, _, err = exec.ExecuteTxs(ctx, page[9:], 1, time.Now(), types.Hash{1})
+		require.NoError(t, err)
+
+		page, _, err = client.GetTxsPage(ctx, next, types.TxLimits{MaxTxs: 1})
+		require.NoError(t, err)
+		assert.Equal(t, expected[10:11], page)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, _, err := client.GetTxsPage(ctx, []byte("invalid"), types.TxLimits{})
+		assert.ErrorIs(t, err, types.ErrInvalidPageToken)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestGetTxsPaginationFallback(t *testing.T) {
+	dummy := test.NewDummyExecutor()
+	// executor without PaginatedTxGetter
+	exec := struct{ execution.Executor }{dummy}
+	config := testServerConfig()
+	handle, err := grpcproxy.StartServer(context.Background(), exec, config)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, handle.Stop()) }()
+	client := startClient(t, handle, config)
+
+	for i := 0; i < 25; i++ {
+		dummy.InjectTx(types.Tx(fmt.Sprintf("tx-%d", i)))
+	}
+	expected, err := dummy.GetTxs(context.Background())
+	require.NoError(t, err)
+
+	it := client.IterateTxs(context.Background(), 10)
+	var txs []types.Tx
+	for it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, expected, txs)
+}
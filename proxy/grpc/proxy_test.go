@@ -3,17 +3,21 @@ package grpc_test
 import (
 	"context"
 	"net"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 
 	grpcproxy "github.com/rollkit/go-execution/proxy/grpc"
 	"github.com/rollkit/go-execution/test"
+	"github.com/rollkit/go-execution/types"
 	pb "github.com/rollkit/go-execution/types/pb/execution"
 )
 
@@ -30,6 +34,9 @@ type ProxyTestSuite struct {
 	server  *grpc.Server
 	client  *grpcproxy.Client
 	cleanup func()
+
+	// unixSocket makes the suite communicate over a real Unix domain socket instead of in-memory connection
+	unixSocket bool
 }
 
 func (s *ProxyTestSuite) SetupTest() {
@@ -40,7 +47,23 @@ func (s *ProxyTestSuite) SetupTest() {
 	}
 	server := grpcproxy.NewServer(exec, config)
 
-	listener := bufconn.Listen(bufSize)
+	var listener net.Listener
+	target := "passthrough://bufnet"
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+	if s.unixSocket {
+		target = "unix://" + filepath.Join(socketDir(s.T()), "exec.sock")
+		var err error
+		listener, err = grpcproxy.Listen(target, 0o600)
+		require.NoError(s.T(), err)
+	} else {
+		bufListener := bufconn.Listen(bufSize)
+		listener = bufListener
+		opts = append(opts, grpc.WithContextDialer(dialer(bufListener)))
+	}
+
+	// no interceptors: Server itself converts errors to gRPC status errors
 	s.server = grpc.NewServer()
 	pb.RegisterExecutionServiceServer(s.server, server)
 
@@ -56,12 +79,7 @@ func (s *ProxyTestSuite) SetupTest() {
 	_, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 
-	opts := []grpc.DialOption{
-		grpc.WithContextDialer(dialer(listener)),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	}
-
-	err := client.Start("passthrough://bufnet", opts...)
+	err := client.Start(target, opts...)
 	require.NoError(s.T(), err)
 
 	for i := 0; i < 10; i++ {
@@ -90,3 +108,21 @@ func (s *ProxyTestSuite) TearDownTest() {
 func TestProxySuite(t *testing.T) {
 	suite.Run(t, new(ProxyTestSuite))
 }
+
+func TestProxySuiteUnixSocket(t *testing.T) {
+	suite.Run(t, &ProxyTestSuite{unixSocket: true})
+}
+
+func (s *ProxyTestSuite) TestErrorSentinels() {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, _, err := s.client.InitChain(ctx, time.Now().UTC(), 0, "test-chain")
+	s.Require().ErrorIs(err, types.ErrZeroInitialHeight)
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	err = s.client.SetFinal(ctx, 123)
+	s.Require().ErrorIs(err, types.ErrBlockNotFound)
+	s.Equal(codes.NotFound, status.Code(err))
+	s.Contains(err.Error(), types.ErrBlockNotFound.Error())
+}
@@ -0,0 +1,185 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"sync"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	reflectionv1 "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionv1alpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+
+	"github.com/rollkit/go-execution"
+	pb "github.com/rollkit/go-execution/types/pb/execution"
+)
+
+// NewGRPCServer creates a gRPC server with Execution API and health checking services registered and
+// all interceptors (logging, metrics, recovery, authentication and error conversion) configured according to config.
+// If config.EnableReflection is set, gRPC server reflection is registered as well.
+// If TLS is configured, server accepts only TLS connections.
+// Additional server options can be passed to customize the server further.
+func NewGRPCServer(exec execution.Executor, config *Config, opts ...grpc.ServerOption) (*grpc.Server, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	tlsConfig, err := config.serverTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	serverOpts := []grpc.ServerOption{
+		// recovery is placed after logging and metrics, so recovered panics are logged and measured as errors
+		grpc.ChainUnaryInterceptor(
+			LoggingUnaryServerInterceptor(config),
+			MetricsUnaryServerInterceptor(config),
+			RecoveryUnaryServerInterceptor(config),
+			AuthUnaryServerInterceptor(config),
+			ErrorUnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			LoggingStreamServerInterceptor(config),
+			MetricsStreamServerInterceptor(config),
+			RecoveryStreamServerInterceptor(config),
+			AuthStreamServerInterceptor(config),
+			ErrorStreamServerInterceptor(),
+		),
+	}
+	if config.MaxRequestSize > 0 {
+		serverOpts = append(serverOpts, grpc.MaxRecvMsgSize(config.MaxRequestSize))
+	}
+	if tlsConfig != nil {
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	serverOpts = append(serverOpts, opts...)
+
+	s := grpc.NewServer(serverOpts...)
+	pb.RegisterExecutionServiceServer(s, NewServer(exec, config))
+	healthpb.RegisterHealthServer(s, NewHealthServer(exec, config))
+	if config.EnableReflection {
+		registerReflection(s)
+	}
+	return s, nil
+}
+
+// registerReflection registers gRPC reflection services. Gogoproto types are not registered in the global
+// protobuf registry, so a hybrid resolver is required to resolve Execution API descriptors.
+func registerReflection(s *grpc.Server) {
+	opts := reflection.ServerOptions{
+		Services:           s,
+		DescriptorResolver: gogoproto.HybridResolver,
+	}
+	reflectionv1.RegisterServerReflectionServer(s, reflection.NewServerV1(opts))
+	reflectionv1alpha.RegisterServerReflectionServer(s, reflection.NewServer(opts))
+}
+
+// ServerHandle controls a gRPC server started with StartServer.
+type ServerHandle struct {
+	server   *grpc.Server
+	listener net.Listener
+	config   *Config
+
+	stopOnce sync.Once
+	done     chan struct{}
+	err      error
+}
+
+// StartServer creates a fully configured gRPC server (see NewGRPCServer) and starts serving on config.ListenAddress.
+// The server is gracefully stopped when ctx is done or when ServerHandle.Stop is called.
+func StartServer(ctx context.Context, exec execution.Executor, config *Config, opts ...grpc.ServerOption) (*ServerHandle, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	server, err := NewGRPCServer(exec, config, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := Listen(config.ListenAddress, config.SocketPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &ServerHandle{
+		server:   server,
+		listener: listener,
+		config:   config,
+		done:     make(chan struct{}),
+	}
+
+	go func() {
+		defer close(h.done)
+		if err := h.server.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			h.err = err
+		}
+	}()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = h.Stop()
+		case <-h.done:
+		}
+	}()
+
+	if config.Logger != nil {
+		config.Logger.Info("gRPC server started", slog.String("address", listener.Addr().String()))
+	}
+
+	return h, nil
+}
+
+// Addr returns the address the server is listening on.
+func (h *ServerHandle) Addr() net.Addr {
+	return h.listener.Addr()
+}
+
+// Stop gracefully stops the server. Pending calls are given config.ShutdownTimeout to complete,
+// after which the server is stopped forcefully. Stop blocks until the server is stopped and returns
+// the error that caused serving to fail, if any.
+func (h *ServerHandle) Stop() error {
+	h.stopOnce.Do(func() {
+		stopped := make(chan struct{})
+		go func() {
+			h.server.GracefulStop()
+			close(stopped)
+		}()
+
+		timeout := h.config.ShutdownTimeout
+		if timeout <= 0 {
+			timeout = DefaultConfig().ShutdownTimeout
+		}
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+
+		select {
+		case <-stopped:
+		case <-timer.C:
+			if h.config.Logger != nil {
+				h.config.Logger.Warn("graceful shutdown timed out, forcing server stop")
+			}
+			h.server.Stop()
+			<-stopped
+		}
+	})
+	return h.Wait()
+}
+
+// Wait blocks until the server is stopped and returns the error that caused serving to fail, if any.
+func (h *ServerHandle) Wait() error {
+	<-h.done
+	return h.err
+}
+
+// Done returns a channel that is closed when the server stops serving.
+func (h *ServerHandle) Done() <-chan struct{} {
+	return h.done
+}
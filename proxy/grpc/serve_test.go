@@ -0,0 +1,224 @@
+package grpc_test
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/rollkit/go-execution/mocks"
+	grpcproxy "github.com/rollkit/go-execution/proxy/grpc"
+	"github.com/rollkit/go-execution/test"
+	"github.com/rollkit/go-execution/types"
+)
+
+type callRecorder struct {
+	mu    sync.Mutex
+	calls map[string]codes.Code
+}
+
+func (r *callRecorder) RecordCall(method string, code codes.Code, _ time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls[method] = code
+}
+
+func (r *callRecorder) code(method string) (codes.Code, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	code, ok := r.calls[method]
+	return code, ok
+}
+
+func testServerConfig() *grpcproxy.Config {
+	config := grpcproxy.DefaultConfig()
+	config.ListenAddress = "127.0.0.1:0"
+	return config
+}
+
+func startClient(t *testing.T, handle *grpcproxy.ServerHandle, config *grpcproxy.Config) *grpcproxy.Client {
+	t.Helper()
+	client := grpcproxy.NewClient()
+	client.SetConfig(config)
+	require.NoError(t, client.Start(handle.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials())))
+	t.Cleanup(func() { _ = client.Stop() })
+	return client
+}
+
+// ServerTestSuite runs ExecutorSuite against a server created with StartServer.
+type ServerTestSuite struct {
+	test.ExecutorSuite
+	handle *grpcproxy.ServerHandle
+	client *grpcproxy.Client
+}
+
+func (s *ServerTestSuite) SetupTest() {
+	exec := test.NewDummyExecutor()
+	config := testServerConfig()
+	config.JWTSecret = []byte("secret")
+
+	handle, err := grpcproxy.StartServer(context.Background(), exec, config)
+	s.Require().NoError(err)
+
+	s.handle = handle
+	s.client = startClient(s.T(), handle, config)
+	s.Exec = s.client
+	s.TxInjector = exec
+}
+
+func (s *ServerTestSuite) TearDownTest() {
+	s.Require().NoError(s.handle.Stop())
+}
+
+func TestServerSuite(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
+
+func TestStartServerAuth(t *testing.T) {
+	config := testServerConfig()
+	config.JWTSecret = []byte("secret")
+	handle, err := grpcproxy.StartServer(context.Background(), test.NewDummyExecutor(), config)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, handle.Stop()) }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	t.Run("missing token", func(t *testing.T) {
+		client := startClient(t, handle, testServerConfig())
+		_, err := client.GetTxs(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("invalid secret", func(t *testing.T) {
+		wrong := testServerConfig()
+		wrong.JWTSecret = []byte("wrong secret")
+		client := startClient(t, handle, wrong)
+		_, err := client.GetTxs(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("valid token", func(t *testing.T) {
+		client := startClient(t, handle, config)
+		_, err := client.GetTxs(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("health is not authenticated", func(t *testing.T) {
+		client := startClient(t, handle, testServerConfig())
+		_, _, err := startClient(t, handle, config).InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+		require.NoError(t, err)
+		assert.NoError(t, client.WaitReady(ctx))
+	})
+}
+
+func TestStartServerRecoveryAndMetrics(t *testing.T) {
+	exec := mocks.NewMockExecutor(t)
+	exec.On("GetTxs", mock.Anything).Panic("boom")
+
+	recorder := &callRecorder{calls: make(map[string]codes.Code)}
+	config := testServerConfig()
+	config.Metrics = recorder
+
+	handle, err := grpcproxy.StartServer(context.Background(), exec, config)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, handle.Stop()) }()
+
+	client := startClient(t, handle, config)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err = client.GetTxs(ctx)
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	code, ok := recorder.code("/execution.ExecutionService/GetTxs")
+	require.True(t, ok)
+	assert.Equal(t, codes.Internal, code)
+}
+
+func TestStartServerStreamLoggingAndMetrics(t *testing.T) {
+	var out syncBuffer
+	recorder := &callRecorder{calls: make(map[string]codes.Code)}
+	config := testServerConfig()
+	config.Metrics = recorder
+	config.Logger = slog.New(slog.NewTextHandler(&out, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	handle, err := grpcproxy.StartServer(context.Background(), test.NewDummyExecutor(), config)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, handle.Stop()) }()
+
+	client := startClient(t, handle, testServerConfig())
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	err = client.LoadSnapshotChunks(ctx, 7, 1, 0, func(uint32, []byte) error { return nil })
+	require.ErrorIs(t, err, types.ErrSnapshotNotFound)
+
+	code, ok := recorder.code("/execution.ExecutionService/LoadSnapshotChunks")
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, code)
+	assert.Contains(t, out.String(), "method=/execution.ExecutionService/LoadSnapshotChunks height=7 first_index=0")
+	assert.Contains(t, out.String(), "code=NotFound")
+}
+
+func TestStartServerReflection(t *testing.T) {
+	config := testServerConfig()
+	config.EnableReflection = true
+	handle, err := grpcproxy.StartServer(context.Background(), test.NewDummyExecutor(), config)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, handle.Stop()) }()
+
+	conn, err := grpc.NewClient(handle.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}))
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	var services []string
+	for _, svc := range resp.GetListServicesResponse().Service {
+		services = append(services, svc.Name)
+	}
+	assert.Contains(t, services, grpcproxy.ServiceName)
+	assert.Contains(t, services, healthpb.Health_ServiceDesc.ServiceName)
+
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: grpcproxy.ServiceName},
+	}))
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.GetFileDescriptorResponse().GetFileDescriptorProto())
+}
+
+func TestStartServerContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	handle, err := grpcproxy.StartServer(ctx, test.NewDummyExecutor(), testServerConfig())
+	require.NoError(t, err)
+
+	cancel()
+	select {
+	case <-handle.Done():
+	case <-time.After(3 * time.Second):
+		t.Fatal("server was not stopped after context cancellation")
+	}
+	assert.NoError(t, handle.Stop())
+}
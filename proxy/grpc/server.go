@@ -2,14 +2,30 @@ package grpc
 
 import (
 	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/rollkit/go-execution"
+	"github.com/rollkit/go-execution/internal/errcode"
 	"github.com/rollkit/go-execution/types"
 	pb "github.com/rollkit/go-execution/types/pb/execution"
 )
 
-// Server defines a gRPC proxy server
+// ProtocolVersion is the version of the Execution API gRPC protocol. It's incremented on incompatible changes;
+// Client refuses to start against a server with a different protocol version.
+const ProtocolVersion = 1
+
+// MaxPageSize is the maximum number of transactions in a page of paginated GetTxs.
+const MaxPageSize = 10_000
+
+// Server defines a gRPC proxy server. Errors returned by the executor are converted to gRPC status errors
+// matching sentinel errors from types package, so Server can be registered on any grpc.Server. Server doesn't
+// authenticate calls; use AuthUnaryServerInterceptor and AuthStreamServerInterceptor (see NewGRPCServer).
 type Server struct {
 	pb.UnimplementedExecutionServiceServer
 	exec   execution.Executor
@@ -27,30 +43,28 @@ func NewServer(exec execution.Executor, config *Config) pb.ExecutionServiceServe
 	}
 }
 
-func (s *Server) validateAuth(ctx context.Context) error {
-	if s.config.JWTSecret != nil {
-		return s.validateJWT(ctx)
+// Info handles Info method call from execution API. Name and version are reported by executors implementing
+// execution.Describer; name of other executors is their Go type.
+func (s *Server) Info(_ context.Context, _ *pb.InfoRequest) (*pb.InfoResponse, error) {
+	resp := &pb.InfoResponse{
+		Name:            fmt.Sprintf("%T", s.exec),
+		ProtocolVersion: ProtocolVersion,
+		Capabilities:    execution.Capabilities(s.exec),
 	}
-	return nil
-}
-
-// TO-DO
-func (s *Server) validateJWT(_ context.Context) error {
-	return nil
+	if describer, ok := s.exec.(execution.Describer); ok {
+		resp.Name, resp.Version = describer.Describe()
+	}
+	return resp, nil
 }
 
 // InitChain handles InitChain method call from execution API.
 func (s *Server) InitChain(ctx context.Context, req *pb.InitChainRequest) (*pb.InitChainResponse, error) {
-	if err := s.validateAuth(ctx); err != nil {
-		return nil, err
-	}
-
 	// Convert Unix timestamp to UTC time
 	genesisTime := time.Unix(req.GenesisTime, 0).UTC()
 
 	stateRoot, maxBytes, err := s.exec.InitChain(ctx, genesisTime, req.InitialHeight, req.ChainId)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	return &pb.InitChainResponse{
@@ -60,22 +74,114 @@ func (s *Server) InitChain(ctx context.Context, req *pb.InitChainRequest) (*pb.I
 }
 
 // GetTxs handles GetTxs method call from execution API.
+// If request has limits and executor doesn't implement execution.LimitedTxGetter, transactions are trimmed
+// to fit into the limits, except for the gas limit. Transactions are paginated if request has page size or
+// page token; every page fits into Config.MaxRequestSize. If request includes info, transactions are returned
+// with metadata (see execution.GetTxsInfo), trimmed to fit into the limits except for the gas limit.
 func (s *Server) GetTxs(ctx context.Context, req *pb.GetTxsRequest) (*pb.GetTxsResponse, error) {
-	txs, err := s.exec.GetTxs(ctx)
+	limits := types.TxLimits{
+		MaxBytes: req.MaxBytes,
+		MaxTxs:   req.MaxTxs,
+		MaxGas:   req.MaxGas,
+	}
+	paginated := req.PageSize > 0 || len(req.PageToken) > 0
+	switch {
+	case req.IncludeInfo && paginated:
+		return nil, status.Error(codes.InvalidArgument, "transaction info can't be requested with pagination")
+	case req.IncludeInfo:
+		return s.getTxsInfo(ctx, limits)
+	case paginated:
+		return s.getTxsPage(ctx, req, limits)
+	}
+
+	txs, err := execution.GetTxsWithLimits(ctx, s.exec, limits)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
-	pbTxs := make([][]byte, len(txs))
-	for i, tx := range txs {
-		pbTxs[i] = tx
+	return &pb.GetTxsResponse{
+		Txs: toPbTxs(txs),
+	}, nil
+}
+
+func (s *Server) getTxsPage(ctx context.Context, req *pb.GetTxsRequest, limits types.TxLimits) (*pb.GetTxsResponse, error) {
+	pageSize := uint64(req.PageSize)
+	if pageSize == 0 || pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+	limits.MaxTxs = minLimit(limits.MaxTxs, pageSize)
+	limits.MaxBytes = minLimit(limits.MaxBytes, s.maxPageBytes(limits.MaxTxs))
+
+	txs, nextPageToken, err := execution.GetTxsPage(ctx, s.exec, req.PageToken, limits)
+	if err != nil {
+		return nil, toStatus(err)
 	}
 
 	return &pb.GetTxsResponse{
-		Txs: pbTxs,
+		Txs:           toPbTxs(txs),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
+func (s *Server) getTxsInfo(ctx context.Context, limits types.TxLimits) (*pb.GetTxsResponse, error) {
+	infos, err := execution.GetTxsInfo(ctx, s.exec)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	txs := make([]types.Tx, len(infos))
+	for i, info := range infos {
+		txs[i] = info.Tx
+	}
+	infos = infos[:len(limits.Trim(txs))]
+
+	pbInfos := make([]*pb.TxInfo, len(infos))
+	for i, info := range infos {
+		pbInfos[i] = &pb.TxInfo{
+			Tx:       info.Tx,
+			Hash:     info.Hash,
+			Priority: info.Priority,
+			Sender:   info.Sender,
+			Nonce:    info.Nonce,
+			Size_:    info.Size,
+		}
+	}
+	return &pb.GetTxsResponse{
+		TxInfos: pbInfos,
+	}, nil
+}
+
+// maxPageBytes returns the maximum total size of transactions in a page with maxTxs transactions, so the
+// response doesn't exceed Config.MaxRequestSize. It returns 0 if the size is not limited.
+func (s *Server) maxPageBytes(maxTxs uint64) uint64 {
+	if s.config.MaxRequestSize <= 0 {
+		return 0
+	}
+	// every transaction is prefixed with tag and length; the rest is reserved for the page token
+	overhead := 1024 + maxTxs*(1+binary.MaxVarintLen32)
+	if uint64(s.config.MaxRequestSize) <= overhead {
+		// pages contain at least one transaction anyway
+		return 1
+	}
+	return uint64(s.config.MaxRequestSize) - overhead
+}
+
+// minLimit returns the lower of two limits, where 0 means no limit.
+func minLimit(a, b uint64) uint64 {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+func toPbTxs(txs []types.Tx) [][]byte {
+	pbTxs := make([][]byte, len(txs))
+	for i, tx := range txs {
+		pbTxs[i] = tx
+	}
+	return pbTxs
+}
+
 // ExecuteTxs handles ExecuteTxs method call from execution API.
 func (s *Server) ExecuteTxs(ctx context.Context, req *pb.ExecuteTxsRequest) (*pb.ExecuteTxsResponse, error) {
 	txs := make([]types.Tx, len(req.Txs))
@@ -94,7 +200,7 @@ func (s *Server) ExecuteTxs(ctx context.Context, req *pb.ExecuteTxsRequest) (*pb
 		prevStateRoot,
 	)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	return &pb.ExecuteTxsResponse{
@@ -107,8 +213,278 @@ func (s *Server) ExecuteTxs(ctx context.Context, req *pb.ExecuteTxsRequest) (*pb
 func (s *Server) SetFinal(ctx context.Context, req *pb.SetFinalRequest) (*pb.SetFinalResponse, error) {
 	err := s.exec.SetFinal(ctx, req.BlockHeight)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	return &pb.SetFinalResponse{}, nil
 }
+
+// SetFinalRange handles SetFinalRange method call from execution API.
+// If executor doesn't implement execution.RangeFinalizer, SetFinal is called for every height in the range.
+func (s *Server) SetFinalRange(ctx context.Context, req *pb.SetFinalRangeRequest) (*pb.SetFinalRangeResponse, error) {
+	err := execution.SetFinalRange(ctx, s.exec, req.FromHeight, req.ToHeight)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.SetFinalRangeResponse{}, nil
+}
+
+// SimulateTxs handles SimulateTxs method call from execution API.
+// It returns Unimplemented error if executor doesn't implement execution.Simulator.
+func (s *Server) SimulateTxs(ctx context.Context, req *pb.SimulateTxsRequest) (*pb.SimulateTxsResponse, error) {
+	simulator, ok := s.exec.(execution.Simulator)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "executor doesn't support simulation")
+	}
+
+	txs := make([]types.Tx, len(req.Txs))
+	for i, tx := range req.Txs {
+		txs[i] = tx
+	}
+
+	result, err := simulator.SimulateTxs(ctx, txs, req.BlockHeight, time.Unix(req.Timestamp, 0), req.PrevStateRoot)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	rejected := make([]*pb.RejectedTx, len(result.RejectedTxs))
+	for i, r := range result.RejectedTxs {
+		rejected[i] = &pb.RejectedTx{Index: uint32(r.Index), Reason: r.Reason} //nolint:gosec
+	}
+	return &pb.SimulateTxsResponse{
+		StateRoot:   result.StateRoot,
+		MaxBytes:    result.MaxBytes,
+		RejectedTxs: rejected,
+		GasUsed:     result.GasUsed,
+	}, nil
+}
+
+// VerifyBlock handles VerifyBlock method call from execution API.
+// State root mismatch is reported in the response, other errors are returned. It returns Unimplemented error
+// if executor doesn't implement execution.Verifier, as executing the block with ExecuteTxs would leave it
+// pending even if state roots differ.
+func (s *Server) VerifyBlock(ctx context.Context, req *pb.VerifyBlockRequest) (*pb.VerifyBlockResponse, error) {
+	verifier, ok := s.exec.(execution.Verifier)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "executor doesn't support verification")
+	}
+
+	txs := make([]types.Tx, len(req.Txs))
+	for i, tx := range req.Txs {
+		txs[i] = tx
+	}
+
+	err := verifier.VerifyBlock(ctx, txs, req.BlockHeight, time.Unix(req.Timestamp, 0), req.PrevStateRoot, req.ExpectedStateRoot)
+	var mismatch *types.StateRootMismatchError
+	if errors.As(err, &mismatch) {
+		return &pb.VerifyBlockResponse{Valid: false, StateRoot: mismatch.Actual}, nil
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.VerifyBlockResponse{Valid: true, StateRoot: req.ExpectedStateRoot}, nil
+}
+
+// ExecuteBlocks handles ExecuteBlocks method call from execution API.
+// Failure of a block is reported in the response, with state roots of blocks executed before it. If executor
+// doesn't implement execution.BatchExecutor, blocks are executed one by one with ExecuteTxs.
+func (s *Server) ExecuteBlocks(ctx context.Context, req *pb.ExecuteBlocksRequest) (*pb.ExecuteBlocksResponse, error) {
+	blocks := make([]types.BatchBlock, len(req.Blocks))
+	for i, block := range req.Blocks {
+		blocks[i] = types.BatchBlock{
+			Txs:       make([]types.Tx, len(block.Txs)),
+			Height:    block.BlockHeight,
+			Timestamp: time.Unix(block.Timestamp, 0),
+		}
+		for j, tx := range block.Txs {
+			blocks[i].Txs[j] = tx
+		}
+	}
+
+	stateRoots, maxBytes, err := execution.ExecuteBlocks(ctx, s.exec, blocks, req.PrevStateRoot)
+	resp := &pb.ExecuteBlocksResponse{
+		StateRoots: make([][]byte, len(stateRoots)),
+		MaxBytes:   maxBytes,
+	}
+	for i, stateRoot := range stateRoots {
+		resp.StateRoots[i] = stateRoot
+	}
+	var batchErr *types.BatchError
+	if errors.As(err, &batchErr) {
+		resp.Failed = true
+		resp.Error = batchErr.Err.Error()
+		if code, ok := errcode.Code(batchErr.Err); ok {
+			resp.ErrorCode = uint32(code) //nolint:gosec
+		}
+		return resp, nil
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return resp, nil
+}
+
+// Prune handles Prune method call from execution API.
+// It returns Unimplemented error if executor doesn't implement execution.Pruner.
+func (s *Server) Prune(ctx context.Context, req *pb.PruneRequest) (*pb.PruneResponse, error) {
+	pruner, ok := s.exec.(execution.Pruner)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "executor doesn't support pruning")
+	}
+
+	if err := pruner.Prune(ctx, req.RetainHeight, req.KeepEvery); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.PruneResponse{}, nil
+}
+
+// StateRootAt handles StateRootAt method call from execution API.
+// It returns Unimplemented error if executor doesn't implement execution.StateQuerier.
+func (s *Server) StateRootAt(ctx context.Context, req *pb.StateRootAtRequest) (*pb.StateRootAtResponse, error) {
+	querier, ok := s.exec.(execution.StateQuerier)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "executor doesn't support state queries")
+	}
+
+	stateRoot, finalized, err := querier.StateRootAt(ctx, req.Height)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.StateRootAtResponse{
+		StateRoot: stateRoot,
+		Finalized: finalized,
+	}, nil
+}
+
+// ChainStatus handles ChainStatus method call from execution API.
+// It returns Unimplemented error if executor doesn't implement execution.StateQuerier.
+func (s *Server) ChainStatus(ctx context.Context, _ *pb.ChainStatusRequest) (*pb.ChainStatusResponse, error) {
+	querier, ok := s.exec.(execution.StateQuerier)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "executor doesn't support state queries")
+	}
+
+	chainStatus, err := querier.ChainStatus(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.ChainStatusResponse{
+		LatestHeight:       chainStatus.LatestHeight,
+		FinalizedHeight:    chainStatus.FinalizedHeight,
+		FinalizedStateRoot: chainStatus.FinalizedStateRoot,
+	}, nil
+}
+
+// ListSnapshots handles ListSnapshots method call from execution API.
+// It returns Unimplemented error if executor doesn't implement execution.SnapshotProvider.
+func (s *Server) ListSnapshots(ctx context.Context, _ *pb.ListSnapshotsRequest) (*pb.ListSnapshotsResponse, error) {
+	provider, ok := s.exec.(execution.SnapshotProvider)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "executor doesn't support snapshots")
+	}
+
+	snapshots, err := provider.ListSnapshots(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &pb.ListSnapshotsResponse{
+		Snapshots: make([]*pb.Snapshot, len(snapshots)),
+	}
+	for i, snapshot := range snapshots {
+		resp.Snapshots[i] = toPbSnapshot(snapshot)
+	}
+	return resp, nil
+}
+
+// LoadSnapshotChunks handles LoadSnapshotChunks method call from execution API, streaming requested chunks
+// in order. It returns Unimplemented error if executor doesn't implement execution.SnapshotProvider.
+func (s *Server) LoadSnapshotChunks(req *pb.LoadSnapshotChunksRequest, stream pb.ExecutionService_LoadSnapshotChunksServer) error {
+	provider, ok := s.exec.(execution.SnapshotProvider)
+	if !ok {
+		return status.Error(codes.Unimplemented, "executor doesn't support snapshots")
+	}
+	ctx := stream.Context()
+
+	end := uint64(req.FirstIndex) + uint64(req.Count)
+	if req.Count == 0 {
+		snapshots, err := provider.ListSnapshots(ctx)
+		if err != nil {
+			return toStatus(err)
+		}
+		end = 0
+		for _, snapshot := range snapshots {
+			if snapshot.Height == req.Height && snapshot.Format == req.Format {
+				end = uint64(snapshot.Chunks)
+			}
+		}
+		if end == 0 {
+			return toStatus(types.ErrSnapshotNotFound)
+		}
+	}
+
+	for index := uint64(req.FirstIndex); index < end; index++ {
+		chunk, err := provider.LoadSnapshotChunk(ctx, req.Height, req.Format, uint32(index)) //nolint:gosec
+		if err != nil {
+			return toStatus(err)
+		}
+		if err := stream.Send(&pb.SnapshotChunk{Index: uint32(index), Chunk: chunk}); err != nil { //nolint:gosec
+			return toStatus(err)
+		}
+	}
+	return nil
+}
+
+// OfferSnapshot handles OfferSnapshot method call from execution API.
+// It returns Unimplemented error if executor doesn't implement execution.SnapshotRestorer.
+func (s *Server) OfferSnapshot(ctx context.Context, req *pb.OfferSnapshotRequest) (*pb.OfferSnapshotResponse, error) {
+	restorer, ok := s.exec.(execution.SnapshotRestorer)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "executor doesn't support snapshot restoration")
+	}
+	if req.Snapshot == nil {
+		return nil, status.Error(codes.InvalidArgument, "snapshot is required")
+	}
+
+	if err := restorer.OfferSnapshot(ctx, fromPbSnapshot(req.Snapshot)); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.OfferSnapshotResponse{}, nil
+}
+
+// ApplySnapshotChunk handles ApplySnapshotChunk method call from execution API.
+// It returns Unimplemented error if executor doesn't implement execution.SnapshotRestorer.
+func (s *Server) ApplySnapshotChunk(ctx context.Context, req *pb.ApplySnapshotChunkRequest) (*pb.ApplySnapshotChunkResponse, error) {
+	restorer, ok := s.exec.(execution.SnapshotRestorer)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "executor doesn't support snapshot restoration")
+	}
+
+	done, err := restorer.ApplySnapshotChunk(ctx, req.Index, req.Chunk)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.ApplySnapshotChunkResponse{Done: done}, nil
+}
+
+func toPbSnapshot(snapshot types.Snapshot) *pb.Snapshot {
+	return &pb.Snapshot{
+		Height:    snapshot.Height,
+		Format:    snapshot.Format,
+		Chunks:    snapshot.Chunks,
+		Hash:      snapshot.Hash,
+		StateRoot: snapshot.StateRoot,
+		Metadata:  snapshot.Metadata,
+	}
+}
+
+func fromPbSnapshot(snapshot *pb.Snapshot) types.Snapshot {
+	return types.Snapshot{
+		Height:    snapshot.Height,
+		Format:    snapshot.Format,
+		Chunks:    snapshot.Chunks,
+		Hash:      snapshot.Hash,
+		StateRoot: snapshot.StateRoot,
+		Metadata:  snapshot.Metadata,
+	}
+}
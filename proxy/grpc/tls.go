@@ -0,0 +1,194 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrNoServerCertificate is returned when server TLS is requested without certificate.
+var ErrNoServerCertificate = errors.New("TLS enabled, but no server certificate configured")
+
+// tlsEnabled returns true if any of the TLS settings is configured.
+func (c *Config) tlsEnabled() bool {
+	return c.TLSConfig != nil || c.TLSCertFile != "" || c.TLSKeyFile != "" || c.TLSCAFile != ""
+}
+
+// serverTLSConfig builds TLS configuration used by the server. It returns nil if TLS is not configured.
+// If TLSCAFile is set, clients are required to present certificate signed by one of the CAs (mutual TLS).
+// Certificate, key and CA files are reloaded when they change on disk.
+func (c *Config) serverTLSConfig() (*tls.Config, error) {
+	if !c.tlsEnabled() {
+		return nil, nil
+	}
+
+	cfg := c.baseTLSConfig()
+	files := newTLSFiles(c.TLSCertFile, c.TLSKeyFile, c.TLSCAFile)
+	if files.hasCertificate() {
+		if _, err := files.certificate(); err != nil {
+			return nil, err
+		}
+		cfg.GetCertificate = func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return files.certificate()
+		}
+	} else if len(cfg.Certificates) == 0 && cfg.GetCertificate == nil && cfg.GetConfigForClient == nil {
+		return nil, ErrNoServerCertificate
+	}
+
+	if files.hasCA() {
+		if _, err := files.caPool(); err != nil {
+			return nil, err
+		}
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		cfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			pool, err := files.caPool()
+			if err != nil {
+				return nil, err
+			}
+			clientCfg := cfg.Clone()
+			clientCfg.GetConfigForClient = nil
+			clientCfg.ClientCAs = pool
+			return clientCfg, nil
+		}
+	}
+
+	return cfg, nil
+}
+
+// clientTLSConfig builds TLS configuration used by the client. It returns nil if TLS is not configured.
+// If TLSCertFile and TLSKeyFile are set, the client presents the certificate to the server (mutual TLS);
+// the certificate is reloaded when the files change on disk. TLSCAFile is used to verify the server certificate.
+func (c *Config) clientTLSConfig() (*tls.Config, error) {
+	if !c.tlsEnabled() {
+		return nil, nil
+	}
+
+	cfg := c.baseTLSConfig()
+	files := newTLSFiles(c.TLSCertFile, c.TLSKeyFile, c.TLSCAFile)
+	if files.hasCertificate() {
+		if _, err := files.certificate(); err != nil {
+			return nil, err
+		}
+		cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return files.certificate()
+		}
+	}
+	if files.hasCA() {
+		pool, err := files.caPool()
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = pool
+	}
+
+	return cfg, nil
+}
+
+func (c *Config) baseTLSConfig() *tls.Config {
+	if c.TLSConfig != nil {
+		return c.TLSConfig.Clone()
+	}
+	return &tls.Config{MinVersion: tls.VersionTLS12}
+}
+
+// tlsFiles loads certificates from files and reloads them when file modification time changes.
+type tlsFiles struct {
+	certFile, keyFile, caFile string
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	certMod [2]time.Time
+	pool    *x509.CertPool
+	poolMod time.Time
+}
+
+func newTLSFiles(certFile, keyFile, caFile string) *tlsFiles {
+	return &tlsFiles{certFile: certFile, keyFile: keyFile, caFile: caFile}
+}
+
+func (f *tlsFiles) hasCertificate() bool {
+	return f.certFile != "" || f.keyFile != ""
+}
+
+func (f *tlsFiles) hasCA() bool {
+	return f.caFile != ""
+}
+
+// certificate returns current key pair. If files changed on disk but can't be loaded
+// (e.g. they are in the middle of being rewritten), previously loaded certificate is returned.
+func (f *tlsFiles) certificate() (*tls.Certificate, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	certMod, err := modTime(f.certFile)
+	if err != nil {
+		return f.cachedCertificate(err)
+	}
+	keyMod, err := modTime(f.keyFile)
+	if err != nil {
+		return f.cachedCertificate(err)
+	}
+	if f.cert != nil && f.certMod == [2]time.Time{certMod, keyMod} {
+		return f.cert, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(f.certFile, f.keyFile)
+	if err != nil {
+		return f.cachedCertificate(fmt.Errorf("failed to load TLS key pair: %w", err))
+	}
+	f.cert = &cert
+	f.certMod = [2]time.Time{certMod, keyMod}
+	return f.cert, nil
+}
+
+func (f *tlsFiles) cachedCertificate(err error) (*tls.Certificate, error) {
+	if f.cert != nil {
+		return f.cert, nil
+	}
+	return nil, err
+}
+
+// caPool returns current CA pool. If file changed on disk but can't be loaded, previously loaded pool is returned.
+func (f *tlsFiles) caPool() (*x509.CertPool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	mod, err := modTime(f.caFile)
+	if err != nil {
+		return f.cachedPool(err)
+	}
+	if f.pool != nil && f.poolMod.Equal(mod) {
+		return f.pool, nil
+	}
+
+	pem, err := os.ReadFile(f.caFile)
+	if err != nil {
+		return f.cachedPool(err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return f.cachedPool(fmt.Errorf("no valid certificates found in %q", f.caFile))
+	}
+	f.pool = pool
+	f.poolMod = mod
+	return f.pool, nil
+}
+
+func (f *tlsFiles) cachedPool(err error) (*x509.CertPool, error) {
+	if f.pool != nil {
+		return f.pool, nil
+	}
+	return nil, err
+}
+
+func modTime(path string) (time.Time, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return info.ModTime(), nil
+}
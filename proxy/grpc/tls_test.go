@@ -0,0 +1,207 @@
+package grpc_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	grpcproxy "github.com/rollkit/go-execution/proxy/grpc"
+	"github.com/rollkit/go-execution/test"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue creates certificate signed by the CA and writes it (with private key) into dir.
+func (ca *testCA) issue(t *testing.T, dir, name string, usage x509.ExtKeyUsage) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, name+".crt")
+	keyFile = filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certFile, keyFile
+}
+
+func (ca *testCA) write(t *testing.T, dir, name string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, ca.pem, 0o600))
+	return path
+}
+
+func startTLSClient(t *testing.T, handle *grpcproxy.ServerHandle, config *grpcproxy.Config) *grpcproxy.Client {
+	t.Helper()
+	client := grpcproxy.NewClient()
+	client.SetConfig(config)
+	require.NoError(t, client.Start(handle.Addr().String()))
+	t.Cleanup(func() { _ = client.Stop() })
+	return client
+}
+
+func TestTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, "ca")
+	caFile := ca.write(t, dir, "ca.crt")
+	certFile, keyFile := ca.issue(t, dir, "server", x509.ExtKeyUsageServerAuth)
+
+	config := testServerConfig()
+	config.TLSCertFile = certFile
+	config.TLSKeyFile = keyFile
+	handle, err := grpcproxy.StartServer(context.Background(), test.NewDummyExecutor(), config)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, handle.Stop()) }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	t.Run("trusted server", func(t *testing.T) {
+		clientConfig := grpcproxy.DefaultConfig()
+		clientConfig.TLSCAFile = caFile
+		_, err := startTLSClient(t, handle, clientConfig).GetTxs(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("untrusted server", func(t *testing.T) {
+		clientConfig := grpcproxy.DefaultConfig()
+		clientConfig.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		_, err := startTLSClient(t, handle, clientConfig).GetTxs(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, "ca")
+	caFile := ca.write(t, dir, "ca.crt")
+	serverCert, serverKey := ca.issue(t, dir, "server", x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := ca.issue(t, dir, "client", x509.ExtKeyUsageClientAuth)
+
+	config := testServerConfig()
+	config.TLSCertFile = serverCert
+	config.TLSKeyFile = serverKey
+	config.TLSCAFile = caFile
+	handle, err := grpcproxy.StartServer(context.Background(), test.NewDummyExecutor(), config)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, handle.Stop()) }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	t.Run("with client certificate", func(t *testing.T) {
+		clientConfig := grpcproxy.DefaultConfig()
+		clientConfig.TLSCAFile = caFile
+		clientConfig.TLSCertFile = clientCert
+		clientConfig.TLSKeyFile = clientKey
+		_, err := startTLSClient(t, handle, clientConfig).GetTxs(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("without client certificate", func(t *testing.T) {
+		clientConfig := grpcproxy.DefaultConfig()
+		clientConfig.TLSCAFile = caFile
+		_, err := startTLSClient(t, handle, clientConfig).GetTxs(ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("client certificate from unknown CA", func(t *testing.T) {
+		otherCert, otherKey := newTestCA(t, "other").issue(t, t.TempDir(), "client", x509.ExtKeyUsageClientAuth)
+		clientConfig := grpcproxy.DefaultConfig()
+		clientConfig.TLSCAFile = caFile
+		clientConfig.TLSCertFile = otherCert
+		clientConfig.TLSKeyFile = otherKey
+		_, err := startTLSClient(t, handle, clientConfig).GetTxs(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestTLSCertificateReload(t *testing.T) {
+	dir := t.TempDir()
+	oldCA := newTestCA(t, "old")
+	newCA := newTestCA(t, "new")
+	serverCert, serverKey := oldCA.issue(t, dir, "server", x509.ExtKeyUsageServerAuth)
+
+	config := testServerConfig()
+	config.TLSCertFile = serverCert
+	config.TLSKeyFile = serverKey
+	handle, err := grpcproxy.StartServer(context.Background(), test.NewDummyExecutor(), config)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, handle.Stop()) }()
+
+	clientConfig := grpcproxy.DefaultConfig()
+	clientConfig.TLSCAFile = newCA.write(t, t.TempDir(), "ca.crt")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err = startTLSClient(t, handle, clientConfig).GetTxs(ctx)
+	require.Error(t, err)
+
+	// rotate server certificate on disk; ensure modification time changes even on coarse-grained file systems
+	newCA.issue(t, dir, "server", x509.ExtKeyUsageServerAuth)
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(serverCert, future, future))
+	require.NoError(t, os.Chtimes(serverKey, future, future))
+
+	_, err = startTLSClient(t, handle, clientConfig).GetTxs(ctx)
+	require.NoError(t, err)
+}
+
+func TestTLSWithoutServerCertificate(t *testing.T) {
+	config := testServerConfig()
+	config.TLSCAFile = "ca.crt"
+	_, err := grpcproxy.StartServer(context.Background(), test.NewDummyExecutor(), config)
+	assert.ErrorIs(t, err, grpcproxy.ErrNoServerCertificate)
+}
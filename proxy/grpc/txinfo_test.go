@@ -0,0 +1,20 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	grpcproxy "github.com/rollkit/go-execution/proxy/grpc"
+	"github.com/rollkit/go-execution/test"
+	pb "github.com/rollkit/go-execution/types/pb/execution"
+)
+
+func TestGetTxsInfoWithPagination(t *testing.T) {
+	server := grpcproxy.NewServer(test.NewDummyExecutor(), nil)
+	_, err := server.GetTxs(context.Background(), &pb.GetTxsRequest{IncludeInfo: true, PageSize: 10})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,87 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	grpcproxy "github.com/rollkit/go-execution/proxy/grpc"
+	"github.com/rollkit/go-execution/test"
+)
+
+// socketDir returns short temporary directory, as Unix domain socket paths are limited to ~100 characters.
+func socketDir(t *testing.T) string {
+	t.Helper()
+	dir, err := os.MkdirTemp("", "exec")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	return dir
+}
+
+func TestUnixSocketPermissions(t *testing.T) {
+	path := filepath.Join(socketDir(t), "exec.sock")
+	listener, err := grpcproxy.Listen("unix://"+path, 0o600)
+	require.NoError(t, err)
+	defer func() { _ = listener.Close() }()
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	assert.NotZero(t, info.Mode()&os.ModeSocket)
+}
+
+func TestUnixSocketStaleCleanup(t *testing.T) {
+	path := filepath.Join(socketDir(t), "exec.sock")
+
+	// simulate socket file left by crashed server
+	stale, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	require.NoError(t, err)
+	stale.SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+
+	listener, err := grpcproxy.Listen("unix://"+path, 0)
+	require.NoError(t, err)
+
+	// socket used by running server must not be removed
+	_, err = grpcproxy.Listen("unix://"+path, 0)
+	assert.ErrorIs(t, err, grpcproxy.ErrSocketInUse)
+
+	require.NoError(t, listener.Close())
+	_, err = os.Stat(path)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestUnixSocketRegularFile(t *testing.T) {
+	path := filepath.Join(socketDir(t), "exec.sock")
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0o600))
+
+	_, err := grpcproxy.Listen("unix://"+path, 0)
+	assert.Error(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+}
+
+func TestUnixSocketServerStop(t *testing.T) {
+	config := grpcproxy.DefaultConfig()
+	config.ListenAddress = "unix://" + filepath.Join(socketDir(t), "exec.sock")
+	config.ShutdownTimeout = time.Second
+
+	handle, err := grpcproxy.StartServer(context.Background(), test.NewDummyExecutor(), config)
+	require.NoError(t, err)
+	require.NoError(t, handle.Stop())
+
+	// socket file is removed on shutdown, so server can be started again
+	handle, err = grpcproxy.StartServer(context.Background(), test.NewDummyExecutor(), config)
+	require.NoError(t, err)
+	require.NoError(t, handle.Stop())
+}
@@ -0,0 +1,177 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/rollkit/go-execution"
+	"github.com/rollkit/go-execution/types"
+)
+
+// ErrClientNotStarted is returned when Client is used before Start was called.
+var ErrClientNotStarted = errors.New("client not started")
+
+// Client defines JSON-RPC proxy client. It implements execution.Executor interface.
+type Client struct {
+	url    string
+	http   *http.Client
+	config *Config
+	nextID atomic.Uint64
+}
+
+var _ execution.Executor = (*Client)(nil)
+
+// NewClient creates a new instance of Client with default configuration.
+func NewClient() *Client {
+	return &Client{
+		config: DefaultConfig(),
+	}
+}
+
+// SetConfig sets the configuration for the Client instance.
+func (c *Client) SetConfig(config *Config) {
+	if config != nil {
+		c.config = config
+	}
+}
+
+// Start initializes the Client to send requests to the JSON-RPC server available at given URL.
+// Optional HTTP client can be provided; http.DefaultClient is used otherwise.
+func (c *Client) Start(url string, httpClient ...*http.Client) error {
+	c.url = url
+	c.http = http.DefaultClient
+	if len(httpClient) > 0 && httpClient[0] != nil {
+		c.http = httpClient[0]
+	}
+	return nil
+}
+
+// Stop stops the client by closing idle connections.
+func (c *Client) Stop() error {
+	if c.http != nil {
+		c.http.CloseIdleConnections()
+	}
+	return nil
+}
+
+// InitChain initializes the blockchain with genesis information.
+func (c *Client) InitChain(ctx context.Context, genesisTime time.Time, initialHeight uint64, chainID string) (types.Hash, uint64, error) {
+	var result InitChainResult
+	err := c.call(ctx, methodInitChain, &InitChainParams{
+		GenesisTime:   genesisTime.Unix(),
+		InitialHeight: initialHeight,
+		ChainID:       chainID,
+	}, &result)
+	if err != nil {
+		return types.Hash{}, 0, err
+	}
+
+	return types.Hash(result.StateRoot), result.MaxBytes, nil
+}
+
+// GetTxs retrieves all available transactions from the execution client's mempool.
+func (c *Client) GetTxs(ctx context.Context) ([]types.Tx, error) {
+	var result GetTxsResult
+	if err := c.call(ctx, methodGetTxs, nil, &result); err != nil {
+		return nil, err
+	}
+
+	txs := make([]types.Tx, len(result.Txs))
+	for i, tx := range result.Txs {
+		txs[i] = types.Tx(tx)
+	}
+	return txs, nil
+}
+
+// ExecuteTxs executes a set of transactions to produce a new block header.
+func (c *Client) ExecuteTxs(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot types.Hash) (types.Hash, uint64, error) {
+	params := &ExecuteTxsParams{
+		Txs:           make([]types.HexBytes, len(txs)),
+		BlockHeight:   blockHeight,
+		Timestamp:     timestamp.Unix(),
+		PrevStateRoot: types.HexBytes(prevStateRoot),
+	}
+	for i, tx := range txs {
+		params.Txs[i] = types.HexBytes(tx)
+	}
+
+	var result ExecuteTxsResult
+	if err := c.call(ctx, methodExecuteTxs, params, &result); err != nil {
+		return types.Hash{}, 0, err
+	}
+
+	return types.Hash(result.UpdatedStateRoot), result.MaxBytes, nil
+}
+
+// SetFinal marks a block at the given height as final.
+func (c *Client) SetFinal(ctx context.Context, blockHeight uint64) error {
+	return c.call(ctx, methodSetFinal, &SetFinalParams{BlockHeight: blockHeight}, nil)
+}
+
+// call sends JSON-RPC request and decodes result into result (if not nil).
+// Errors returned by the server are returned as *Error, wrapping sentinel errors from types package.
+func (c *Client) call(ctx context.Context, method string, params any, result any) error {
+	if c.http == nil {
+		return ErrClientNotStarted
+	}
+	if _, ok := ctx.Deadline(); !ok && c.config.DefaultTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.config.DefaultTimeout)
+		defer cancel()
+	}
+
+	req := request{
+		JSONRPC: version,
+		ID:      json.RawMessage(fmt.Sprint(c.nextID.Add(1))),
+		Method:  method,
+	}
+	if params != nil {
+		encoded, err := json.Marshal(params)
+		if err != nil {
+			return err
+		}
+		req.Params = encoded
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := c.http.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = httpResp.Body.Close()
+	}()
+
+	if httpResp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(httpResp.Body, 1024))
+		return fmt.Errorf("unexpected HTTP status %s: %s", httpResp.Status, bytes.TrimSpace(msg))
+	}
+
+	var resp response
+	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return fmt.Errorf("failed to decode JSON-RPC response: %w", err)
+	}
+	if resp.Error != nil {
+		return resp.Error
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(resp.Result, result)
+}
@@ -0,0 +1,20 @@
+package jsonrpc
+
+import "time"
+
+// Config holds configuration settings for the JSON-RPC proxy.
+type Config struct {
+	// DefaultTimeout is used by the client for calls made with context without deadline.
+	DefaultTimeout time.Duration
+	// MaxRequestSize is the maximum size of a request body received by the server. Requests are not limited
+	// if it's 0.
+	MaxRequestSize int64
+}
+
+// DefaultConfig returns a Config instance populated with default settings.
+func DefaultConfig() *Config {
+	return &Config{
+		DefaultTimeout: time.Second,
+		MaxRequestSize: 1024 * 1024,
+	}
+}
@@ -0,0 +1,48 @@
+package jsonrpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rollkit/go-execution/internal/errcode"
+)
+
+// Standard JSON-RPC 2.0 error codes.
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+	// CodeServerError is used for execution errors that don't map to any of the sentinel errors from types package.
+	CodeServerError = -32000
+)
+
+// Error is a JSON-RPC 2.0 error object.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error implements error interface.
+func (e *Error) Error() string {
+	return fmt.Sprintf("json-rpc error %d: %s", e.Code, e.Message)
+}
+
+// Unwrap returns sentinel error from types package corresponding to the error code, if any.
+// This enables usage of errors.Is on errors returned by Client.
+func (e *Error) Unwrap() error {
+	return errcode.Err(e.Code)
+}
+
+// toError converts error returned by Executor into JSON-RPC error object.
+func toError(err error) *Error {
+	var rpcErr *Error
+	if errors.As(err, &rpcErr) {
+		return rpcErr
+	}
+	if code, ok := errcode.Code(err); ok {
+		return &Error{Code: code, Message: err.Error()}
+	}
+	return &Error{Code: CodeServerError, Message: err.Error()}
+}
@@ -0,0 +1,185 @@
+package jsonrpc_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/rollkit/go-execution/proxy/jsonrpc"
+	"github.com/rollkit/go-execution/test"
+	"github.com/rollkit/go-execution/types"
+)
+
+type ProxyTestSuite struct {
+	test.ExecutorSuite
+	server *httptest.Server
+	client *jsonrpc.Client
+}
+
+func (s *ProxyTestSuite) SetupTest() {
+	exec := test.NewDummyExecutor()
+	s.server = httptest.NewServer(jsonrpc.NewServer(exec, nil))
+
+	s.client = jsonrpc.NewClient()
+	s.Require().NoError(s.client.Start(s.server.URL, s.server.Client()))
+
+	s.Exec = s.client
+	s.TxInjector = exec
+}
+
+func (s *ProxyTestSuite) TearDownTest() {
+	s.Require().NoError(s.client.Stop())
+	s.server.Close()
+}
+
+func TestProxySuite(t *testing.T) {
+	suite.Run(t, new(ProxyTestSuite))
+}
+
+func (s *ProxyTestSuite) TestErrorSentinels() {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, _, err := s.client.InitChain(ctx, time.Now().UTC(), 0, "test-chain")
+	s.Require().ErrorIs(err, types.ErrZeroInitialHeight)
+
+	_, _, err = s.client.ExecuteTxs(ctx, nil, 1, time.Now(), types.Hash{})
+	s.Require().ErrorIs(err, types.ErrEmptyStateRoot)
+
+	err = s.client.SetFinal(ctx, 123)
+	s.Require().ErrorIs(err, types.ErrBlockNotFound)
+
+	var rpcErr *jsonrpc.Error
+	s.Require().ErrorAs(err, &rpcErr)
+	s.Equal(types.ErrBlockNotFound.Error(), rpcErr.Message)
+}
+
+func post(t *testing.T, url, body string) (int, string) {
+	t.Helper()
+	resp, err := http.Post(url, "application/json", strings.NewReader(body)) //nolint:gosec
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(respBody)
+}
+
+func TestServerWireFormat(t *testing.T) {
+	exec := test.NewDummyExecutor()
+	exec.InjectTx(types.Tx{0xde, 0xad, 0xbe, 0xef})
+	server := httptest.NewServer(jsonrpc.NewServer(exec, nil))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		body     string
+		code     int
+		expected string
+	}{
+		{
+			name:     "hex encoded transactions",
+			body:     `{"jsonrpc":"2.0","id":1,"method":"execution_getTxs"}`,
+			code:     http.StatusOK,
+			expected: `{"jsonrpc":"2.0","id":1,"result":{"txs":["0xdeadbeef"]}}`,
+		},
+		{
+			name:     "unknown method",
+			body:     `{"jsonrpc":"2.0","id":"a","method":"execution_unknown"}`,
+			code:     http.StatusOK,
+			expected: `{"jsonrpc":"2.0","id":"a","error":{"code":-32601,"message":"method not found: execution_unknown"}}`,
+		},
+		{
+			name:     "invalid params",
+			body:     `{"jsonrpc":"2.0","id":2,"method":"execution_setFinal","params":{"blockHeight":"x"}}`,
+			code:     http.StatusOK,
+			expected: `"code":-32602`,
+		},
+		{
+			name:     "parse error",
+			body:     `{"jsonrpc":`,
+			code:     http.StatusOK,
+			expected: `{"jsonrpc":"2.0","id":null,"error":{"code":-32700`,
+		},
+		{
+			name:     "execution error",
+			body:     `{"jsonrpc":"2.0","id":3,"method":"execution_setFinal","params":{"blockHeight":5}}`,
+			code:     http.StatusOK,
+			expected: `{"jsonrpc":"2.0","id":3,"error":{"code":3001,"message":"block not found"}}`,
+		},
+		{
+			name:     "batch",
+			body:     `[{"jsonrpc":"2.0","id":1,"method":"execution_getTxs"},{"jsonrpc":"2.0","id":2,"method":"execution_unknown"}]`,
+			code:     http.StatusOK,
+			expected: `[{"jsonrpc":"2.0","id":1,"result":{"txs":["0xdeadbeef"]}},{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"method not found: execution_unknown"}}]`,
+		},
+		{
+			name: "notification",
+			body: `{"jsonrpc":"2.0","method":"execution_getTxs"}`,
+			code: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, body := post(t, server.URL, tt.body)
+			assert.Equal(t, tt.code, code)
+			assert.Contains(t, body, tt.expected)
+		})
+	}
+}
+
+func TestServerRejectsLargeRequests(t *testing.T) {
+	config := jsonrpc.DefaultConfig()
+	config.MaxRequestSize = 16
+	server := httptest.NewServer(jsonrpc.NewServer(test.NewDummyExecutor(), config))
+	defer server.Close()
+
+	code, _ := post(t, server.URL, `{"jsonrpc":"2.0","id":1,"method":"execution_getTxs"}`)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, code)
+}
+
+func TestServerUnlimitedRequestSize(t *testing.T) {
+	config := jsonrpc.DefaultConfig()
+	config.MaxRequestSize = 0
+	server := httptest.NewServer(jsonrpc.NewServer(test.NewDummyExecutor(), config))
+	defer server.Close()
+
+	code, body := post(t, server.URL, `{"jsonrpc":"2.0","id":1,"method":"execution_getTxs"}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, `"result"`)
+}
+
+func TestServerBodyReadError(t *testing.T) {
+	server := jsonrpc.NewServer(test.NewDummyExecutor(), nil)
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", iotest.ErrReader(errors.New("connection reset"))))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestClientDefaultTimeout(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		<-unblock
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	config := jsonrpc.DefaultConfig()
+	config.DefaultTimeout = 50 * time.Millisecond
+	client := jsonrpc.NewClient()
+	client.SetConfig(config)
+	require.NoError(t, client.Start(server.URL, server.Client()))
+
+	_, err := client.GetTxs(context.Background())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,204 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rollkit/go-execution"
+	"github.com/rollkit/go-execution/types"
+)
+
+// Server defines a JSON-RPC 2.0 over HTTP proxy server. It implements http.Handler.
+type Server struct {
+	exec   execution.Executor
+	config *Config
+}
+
+// NewServer creates a new JSON-RPC server with the given execution client and configuration.
+func NewServer(exec execution.Executor, config *Config) *Server {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	return &Server{
+		exec:   exec,
+		config: config,
+	}
+}
+
+// ServeHTTP handles JSON-RPC requests. Both single and batch requests are supported.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	reader := r.Body
+	if s.config.MaxRequestSize > 0 {
+		reader = http.MaxBytesReader(w, r.Body, s.config.MaxRequestSize)
+	}
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		if errors.As(err, new(*http.MaxBytesError)) {
+			http.Error(w, "request too large", http.StatusRequestEntityTooLarge)
+		} else {
+			http.Error(w, "failed to read request", http.StatusBadRequest)
+		}
+		return
+	}
+
+	var resp any
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var reqs []json.RawMessage
+		if err := json.Unmarshal(body, &reqs); err != nil || len(reqs) == 0 {
+			resp = errorResponse(nil, &Error{Code: CodeInvalidRequest, Message: "invalid batch request"})
+		} else {
+			resps := make([]*response, 0, len(reqs))
+			for _, raw := range reqs {
+				if r := s.handle(r.Context(), raw); r != nil {
+					resps = append(resps, r)
+				}
+			}
+			if len(resps) == 0 {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			resp = resps
+		}
+	} else {
+		r := s.handle(r.Context(), body)
+		if r == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		resp = r
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// handle processes single JSON-RPC request. It returns nil for notifications (requests without ID).
+func (s *Server) handle(ctx context.Context, raw json.RawMessage) *response {
+	var req request
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return errorResponse(nil, &Error{Code: CodeParseError, Message: err.Error()})
+	}
+	if req.JSONRPC != version || req.Method == "" {
+		return errorResponse(req.ID, &Error{Code: CodeInvalidRequest, Message: "invalid JSON-RPC 2.0 request"})
+	}
+
+	result, rpcErr := s.call(ctx, req.Method, req.Params)
+	if req.ID == nil {
+		return nil
+	}
+	if rpcErr != nil {
+		return errorResponse(req.ID, rpcErr)
+	}
+
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return errorResponse(req.ID, &Error{Code: CodeInternalError, Message: err.Error()})
+	}
+	return &response{JSONRPC: version, ID: req.ID, Result: encoded}
+}
+
+func (s *Server) call(ctx context.Context, method string, params json.RawMessage) (any, *Error) {
+	switch method {
+	case methodInitChain:
+		var p InitChainParams
+		if err := decodeParams(params, &p); err != nil {
+			return nil, err
+		}
+		return s.initChain(ctx, p)
+	case methodGetTxs:
+		return s.getTxs(ctx)
+	case methodExecuteTxs:
+		var p ExecuteTxsParams
+		if err := decodeParams(params, &p); err != nil {
+			return nil, err
+		}
+		return s.executeTxs(ctx, p)
+	case methodSetFinal:
+		var p SetFinalParams
+		if err := decodeParams(params, &p); err != nil {
+			return nil, err
+		}
+		return s.setFinal(ctx, p)
+	}
+	return nil, &Error{Code: CodeMethodNotFound, Message: "method not found: " + method}
+}
+
+func decodeParams(params json.RawMessage, v any) *Error {
+	if len(params) == 0 {
+		return &Error{Code: CodeInvalidParams, Message: "missing params"}
+	}
+	if err := json.Unmarshal(params, v); err != nil {
+		return &Error{Code: CodeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+func errorResponse(id json.RawMessage, err *Error) *response {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	return &response{JSONRPC: version, ID: id, Error: err}
+}
+
+func (s *Server) initChain(ctx context.Context, p InitChainParams) (any, *Error) {
+	genesisTime := time.Unix(p.GenesisTime, 0).UTC()
+
+	stateRoot, maxBytes, err := s.exec.InitChain(ctx, genesisTime, p.InitialHeight, p.ChainID)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return &InitChainResult{
+		StateRoot: types.HexBytes(stateRoot),
+		MaxBytes:  maxBytes,
+	}, nil
+}
+
+func (s *Server) getTxs(ctx context.Context) (any, *Error) {
+	txs, err := s.exec.GetTxs(ctx)
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	result := &GetTxsResult{Txs: make([]types.HexBytes, len(txs))}
+	for i, tx := range txs {
+		result.Txs[i] = types.HexBytes(tx)
+	}
+	return result, nil
+}
+
+func (s *Server) executeTxs(ctx context.Context, p ExecuteTxsParams) (any, *Error) {
+	txs := make([]types.Tx, len(p.Txs))
+	for i, tx := range p.Txs {
+		txs[i] = types.Tx(tx)
+	}
+
+	updatedStateRoot, maxBytes, err := s.exec.ExecuteTxs(ctx, txs, p.BlockHeight, time.Unix(p.Timestamp, 0), types.Hash(p.PrevStateRoot))
+	if err != nil {
+		return nil, toError(err)
+	}
+
+	return &ExecuteTxsResult{
+		UpdatedStateRoot: types.HexBytes(updatedStateRoot),
+		MaxBytes:         maxBytes,
+	}, nil
+}
+
+func (s *Server) setFinal(ctx context.Context, p SetFinalParams) (any, *Error) {
+	if err := s.exec.SetFinal(ctx, p.BlockHeight); err != nil {
+		return nil, toError(err)
+	}
+	return nil, nil
+}
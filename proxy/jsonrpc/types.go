@@ -0,0 +1,67 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+
+	"github.com/rollkit/go-execution/types"
+)
+
+const (
+	version = "2.0"
+
+	methodInitChain  = "execution_initChain"
+	methodGetTxs     = "execution_getTxs"
+	methodExecuteTxs = "execution_executeTxs"
+	methodSetFinal   = "execution_setFinal"
+)
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// InitChainParams are parameters of execution_initChain method.
+type InitChainParams struct {
+	GenesisTime   int64  `json:"genesisTime"`
+	InitialHeight uint64 `json:"initialHeight"`
+	ChainID       string `json:"chainId"`
+}
+
+// InitChainResult is the result of execution_initChain method.
+type InitChainResult struct {
+	StateRoot types.HexBytes `json:"stateRoot"`
+	MaxBytes  uint64         `json:"maxBytes"`
+}
+
+// GetTxsResult is the result of execution_getTxs method.
+type GetTxsResult struct {
+	Txs []types.HexBytes `json:"txs"`
+}
+
+// ExecuteTxsParams are parameters of execution_executeTxs method.
+type ExecuteTxsParams struct {
+	Txs           []types.HexBytes `json:"txs"`
+	BlockHeight   uint64           `json:"blockHeight"`
+	Timestamp     int64            `json:"timestamp"`
+	PrevStateRoot types.HexBytes   `json:"prevStateRoot"`
+}
+
+// ExecuteTxsResult is the result of execution_executeTxs method.
+type ExecuteTxsResult struct {
+	UpdatedStateRoot types.HexBytes `json:"updatedStateRoot"`
+	MaxBytes         uint64         `json:"maxBytes"`
+}
+
+// SetFinalParams are parameters of execution_setFinal method.
+type SetFinalParams struct {
+	BlockHeight uint64 `json:"blockHeight"`
+}
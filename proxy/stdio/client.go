@@ -0,0 +1,501 @@
+// Package stdio implements Execution API proxy running the execution layer as a child process,
+// communicating with it over standard input and output using length-prefixed protobuf frames.
+package stdio
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/rollkit/go-execution"
+	"github.com/rollkit/go-execution/types"
+	pb "github.com/rollkit/go-execution/types/pb/execution"
+)
+
+// Client implements execution.Executor by forwarding calls to executor running in a child process.
+//
+// Client supervises the child process: if it exits unexpectedly, pending calls fail with ErrProcessExited
+// and the process is restarted with exponential backoff. Calls made while the process is restarting wait
+// until it's running again. Standard error output of the child process is forwarded to Config.Logger.
+type Client struct {
+	config *Config
+	nextID atomic.Uint64
+
+	path string
+	args []string
+
+	mu sync.Mutex
+	// proc is the running child process, or nil if it's not running
+	proc *process
+	// changed is closed and replaced whenever proc changes or the client is stopped
+	changed  chan struct{}
+	err      error
+	restarts int
+	started  bool
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+var (
+	_ execution.Executor        = (*Client)(nil)
+	_ execution.LimitedTxGetter = (*Client)(nil)
+)
+
+// NewClient creates a new instance of Client with default configuration.
+func NewClient() *Client {
+	return &Client{
+		config:  DefaultConfig(),
+		changed: make(chan struct{}),
+	}
+}
+
+// SetConfig sets the configuration for the Client instance.
+func (c *Client) SetConfig(config *Config) {
+	if config != nil {
+		c.config = config
+	}
+}
+
+// Start launches the binary at path with given arguments as the child process, and starts supervising it.
+// Error is returned if the process can't be started.
+func (c *Client) Start(path string, args ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.started {
+		return errors.New("client already started")
+	}
+	c.path = path
+	c.args = args
+
+	p, err := c.launch()
+	if err != nil {
+		return err
+	}
+	c.started = true
+	c.stop = make(chan struct{})
+	c.done = make(chan struct{})
+	c.setProcess(p)
+	go c.supervise(p)
+	return nil
+}
+
+// Stop closes standard input of the child process and waits for it to exit. If it doesn't exit within
+// Config.ShutdownTimeout, it's killed.
+func (c *Client) Stop() error {
+	c.mu.Lock()
+	if !c.started || errors.Is(c.err, ErrClientStopped) {
+		c.mu.Unlock()
+		return nil
+	}
+	c.err = ErrClientStopped
+	close(c.stop)
+	p := c.proc
+	c.setProcess(nil)
+	c.mu.Unlock()
+
+	if p != nil {
+		p.shutdown(c.config.ShutdownTimeout)
+	}
+	<-c.done
+	return nil
+}
+
+// Restarts returns the number of times the child process was restarted.
+func (c *Client) Restarts() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.restarts
+}
+
+// InitChain initializes the blockchain with genesis information.
+func (c *Client) InitChain(ctx context.Context, genesisTime time.Time, initialHeight uint64, chainID string) (types.Hash, uint64, error) {
+	var resp pb.InitChainResponse
+	err := c.call(ctx, kindInitChain, &pb.InitChainRequest{
+		GenesisTime:   genesisTime.Unix(),
+		InitialHeight: initialHeight,
+		ChainId:       chainID,
+	}, &resp)
+	if err != nil {
+		return types.Hash{}, 0, err
+	}
+
+	return resp.StateRoot, resp.MaxBytes, nil
+}
+
+// GetTxs retrieves all available transactions from the execution client's mempool.
+func (c *Client) GetTxs(ctx context.Context) ([]types.Tx, error) {
+	var resp pb.GetTxsResponse
+	if err := c.call(ctx, kindGetTxs, &pb.GetTxsRequest{}, &resp); err != nil {
+		return nil, err
+	}
+
+	txs := make([]types.Tx, len(resp.Txs))
+	for i, tx := range resp.Txs {
+		txs[i] = tx
+	}
+	return txs, nil
+}
+
+// GetTxsWithLimits retrieves available transactions from the execution client's mempool that fit into given limits.
+func (c *Client) GetTxsWithLimits(ctx context.Context, limits types.TxLimits) ([]types.Tx, error) {
+	var resp pb.GetTxsResponse
+	req := &pb.GetTxsRequest{MaxBytes: limits.MaxBytes, MaxTxs: limits.MaxTxs, MaxGas: limits.MaxGas}
+	if err := c.call(ctx, kindGetTxs, req, &resp); err != nil {
+		return nil, err
+	}
+
+	txs := make([]types.Tx, len(resp.Txs))
+	for i, tx := range resp.Txs {
+		txs[i] = tx
+	}
+	return limits.Trim(txs), nil
+}
+
+// ExecuteTxs executes a set of transactions to produce a new block header.
+func (c *Client) ExecuteTxs(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot types.Hash) (types.Hash, uint64, error) {
+	req := &pb.ExecuteTxsRequest{
+		Txs:           make([][]byte, len(txs)),
+		BlockHeight:   blockHeight,
+		Timestamp:     timestamp.Unix(),
+		PrevStateRoot: prevStateRoot,
+	}
+	for i, tx := range txs {
+		req.Txs[i] = tx
+	}
+
+	var resp pb.ExecuteTxsResponse
+	if err := c.call(ctx, kindExecuteTxs, req, &resp); err != nil {
+		return types.Hash{}, 0, err
+	}
+
+	return resp.UpdatedStateRoot, resp.MaxBytes, nil
+}
+
+// SetFinal marks a block at the given height as final.
+func (c *Client) SetFinal(ctx context.Context, blockHeight uint64) error {
+	return c.call(ctx, kindSetFinal, &pb.SetFinalRequest{BlockHeight: blockHeight}, &pb.SetFinalResponse{})
+}
+
+func (c *Client) call(ctx context.Context, kind byte, req, resp proto.Message) error {
+	if _, ok := ctx.Deadline(); !ok && c.config.DefaultTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.config.DefaultTimeout)
+		defer cancel()
+	}
+
+	f, err := messageFrame(c.nextID.Add(1), kind, req)
+	if err != nil {
+		return err
+	}
+
+	var (
+		p      *process
+		respCh <-chan frame
+	)
+	for respCh == nil {
+		if p, err = c.process(ctx); err != nil {
+			return err
+		}
+		respCh = p.register(f.id)
+	}
+	defer p.unregister(f.id)
+	if err := p.write(f); err != nil {
+		return fmt.Errorf("%w: %w", ErrProcessExited, err)
+	}
+
+	select {
+	case out, ok := <-respCh:
+		if !ok {
+			return ErrProcessExited
+		}
+		if out.kind == kindError {
+			return decodeError(out.payload)
+		}
+		return proto.Unmarshal(out.payload, resp)
+	case <-ctx.Done():
+		_ = p.write(frame{id: f.id, kind: kindCancel})
+		return ctx.Err()
+	}
+}
+
+// process returns running child process, waiting for it to be restarted if necessary.
+func (c *Client) process(ctx context.Context) (*process, error) {
+	for {
+		c.mu.Lock()
+		if !c.started {
+			c.mu.Unlock()
+			return nil, ErrClientNotStarted
+		}
+		p, err, changed := c.proc, c.err, c.changed
+		c.mu.Unlock()
+
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			select {
+			case <-p.exited:
+				// supervisor didn't notice the exit yet
+			default:
+				return p, nil
+			}
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// setProcess must be called with c.mu held.
+func (c *Client) setProcess(p *process) {
+	c.proc = p
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+func (c *Client) supervise(p *process) {
+	defer close(c.done)
+
+	delay := c.config.RestartDelay
+	crashes := 0
+	for {
+		startedAt := time.Now()
+		select {
+		case <-p.exited:
+		case <-c.stop:
+			return
+		}
+
+		c.mu.Lock()
+		if c.proc == p {
+			c.setProcess(nil)
+		}
+		c.mu.Unlock()
+		c.log(slog.LevelError, "child process exited", slog.Int("pid", p.cmd.Process.Pid), slog.Any("error", p.err))
+
+		// process running long enough is considered healthy
+		if time.Since(startedAt) > c.config.MaxRestartDelay {
+			delay = c.config.RestartDelay
+			crashes = 0
+		}
+
+		for {
+			crashes++
+			if c.config.MaxRestarts > 0 && crashes > c.config.MaxRestarts {
+				c.fail(ErrTooManyRestarts)
+				return
+			}
+			select {
+			case <-time.After(delay):
+			case <-c.stop:
+				return
+			}
+			delay = min(2*delay, c.config.MaxRestartDelay)
+
+			c.mu.Lock()
+			if errors.Is(c.err, ErrClientStopped) {
+				c.mu.Unlock()
+				return
+			}
+			next, err := c.launch()
+			if err != nil {
+				c.mu.Unlock()
+				c.log(slog.LevelError, "failed to restart child process", slog.Any("error", err))
+				continue
+			}
+			c.restarts++
+			c.setProcess(next)
+			c.mu.Unlock()
+
+			c.log(slog.LevelInfo, "child process restarted", slog.Int("pid", next.cmd.Process.Pid))
+			p = next
+			break
+		}
+	}
+}
+
+func (c *Client) fail(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err == nil {
+		c.err = err
+		c.setProcess(nil)
+	}
+}
+
+func (c *Client) log(level slog.Level, msg string, attrs ...slog.Attr) {
+	if c.config.Logger != nil {
+		c.config.Logger.LogAttrs(context.Background(), level, msg, append(attrs, slog.String("path", c.path))...)
+	}
+}
+
+// launch starts the child process. It must be called with c.mu held.
+func (c *Client) launch() (*process, error) {
+	cmd := exec.Command(c.path, c.args...) //nolint:gosec
+	cmd.Dir = c.config.Dir
+	if len(c.config.Env) > 0 {
+		cmd.Env = append(os.Environ(), c.config.Env...)
+	}
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	p := &process{
+		cmd:     cmd,
+		stdin:   stdin,
+		pending: make(map[uint64]chan frame),
+		exited:  make(chan struct{}),
+	}
+	var output sync.WaitGroup
+	output.Add(2)
+	go func() {
+		defer output.Done()
+		p.readResponses(bufio.NewReader(stdout))
+	}()
+	go func() {
+		defer output.Done()
+		c.forwardStderr(stderr, cmd.Process.Pid)
+	}()
+	go func() {
+		output.Wait()
+		p.err = cmd.Wait()
+		close(p.exited)
+		p.closePending()
+	}()
+	return p, nil
+}
+
+func (c *Client) forwardStderr(stderr io.Reader, pid int) {
+	if c.config.Logger == nil {
+		_, _ = io.Copy(os.Stderr, stderr)
+		return
+	}
+	name := filepath.Base(c.path)
+	scanner := bufio.NewScanner(stderr)
+	for scanner.Scan() {
+		c.config.Logger.LogAttrs(context.Background(), c.config.StderrLogLevel, scanner.Text(),
+			slog.String("process", name),
+			slog.Int("pid", pid),
+		)
+	}
+}
+
+// process is a running child process.
+type process struct {
+	cmd *exec.Cmd
+
+	writeMu sync.Mutex
+	stdin   io.WriteCloser
+
+	mu      sync.Mutex
+	pending map[uint64]chan frame
+	closed  bool
+
+	// exited is closed after the process exited; err is the result of cmd.Wait
+	exited chan struct{}
+	err    error
+}
+
+// register returns channel receiving response to the request with given ID, or nil if the process exited.
+func (p *process) register(id uint64) <-chan frame {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil
+	}
+	ch := make(chan frame, 1)
+	p.pending[id] = ch
+	return ch
+}
+
+func (p *process) unregister(id uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.pending, id)
+}
+
+func (p *process) closePending() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+	for id, ch := range p.pending {
+		close(ch)
+		delete(p.pending, id)
+	}
+}
+
+func (p *process) write(f frame) error {
+	p.writeMu.Lock()
+	defer p.writeMu.Unlock()
+
+	return writeFrame(p.stdin, f)
+}
+
+// readResponses delivers responses to pending calls. Calls whose response exceeds MaxFrameSize fail with
+// an error, without affecting other calls. Child process violating the protocol is killed.
+func (p *process) readResponses(r *bufio.Reader) {
+	for {
+		f, err := readFrame(r, MaxFrameSize)
+		if errors.Is(err, ErrFrameTooLarge) {
+			f = errorFrame(f.id, err)
+		} else if err != nil {
+			if errors.Is(err, ErrInvalidFrame) {
+				_ = p.cmd.Process.Kill()
+				_, _ = io.Copy(io.Discard, r)
+			}
+			return
+		}
+
+		p.mu.Lock()
+		if ch, ok := p.pending[f.id]; ok {
+			ch <- f
+			delete(p.pending, f.id)
+		}
+		p.mu.Unlock()
+	}
+}
+
+func (p *process) shutdown(timeout time.Duration) {
+	p.writeMu.Lock()
+	_ = p.stdin.Close()
+	p.writeMu.Unlock()
+
+	select {
+	case <-p.exited:
+	case <-time.After(timeout):
+		_ = p.cmd.Process.Kill()
+		<-p.exited
+	}
+}
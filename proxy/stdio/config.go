@@ -0,0 +1,46 @@
+package stdio
+
+import (
+	"log/slog"
+	"time"
+)
+
+// MaxFrameSize is the maximum size of a single frame accepted by Client and Serve.
+const MaxFrameSize = 64 * 1024 * 1024
+
+// Config holds configuration settings for the stdio proxy client.
+type Config struct {
+	// Env contains additional environment variables of the child process, in "key=value" form.
+	// Environment of the current process is always inherited.
+	Env []string
+	// Dir is the working directory of the child process. Current directory is used if empty.
+	Dir string
+	// DefaultTimeout is used for calls made with context without deadline.
+	DefaultTimeout time.Duration
+
+	// RestartDelay is the delay before the first restart of crashed child process. The delay is doubled
+	// after each consecutive crash, up to MaxRestartDelay.
+	RestartDelay    time.Duration
+	MaxRestartDelay time.Duration
+	// MaxRestarts is the maximum number of consecutive restarts; 0 means unlimited.
+	MaxRestarts int
+	// ShutdownTimeout is the time given to the child process to exit after its stdin is closed, before it's killed.
+	ShutdownTimeout time.Duration
+
+	// Logger is used to log supervision events and standard error output of the child process.
+	// If Logger is nil, standard error output is copied to os.Stderr.
+	Logger *slog.Logger
+	// StderrLogLevel is the level used to log lines written to standard error by the child process.
+	StderrLogLevel slog.Level
+}
+
+// DefaultConfig returns a Config instance populated with default settings.
+func DefaultConfig() *Config {
+	return &Config{
+		DefaultTimeout:  time.Second,
+		RestartDelay:    100 * time.Millisecond,
+		MaxRestartDelay: 5 * time.Second,
+		ShutdownTimeout: 5 * time.Second,
+		StderrLogLevel:  slog.LevelInfo,
+	}
+}
@@ -0,0 +1,18 @@
+package stdio
+
+import "errors"
+
+var (
+	// ErrClientNotStarted is returned when Client is used before Start was called
+	ErrClientNotStarted = errors.New("client not started")
+	// ErrClientStopped is returned when Client is used after Stop was called
+	ErrClientStopped = errors.New("client stopped")
+	// ErrProcessExited is returned for calls pending when the child process exited
+	ErrProcessExited = errors.New("child process exited")
+	// ErrTooManyRestarts is returned when the child process crashed more than Config.MaxRestarts times in a row
+	ErrTooManyRestarts = errors.New("child process restarted too many times")
+	// ErrInvalidFrame is returned when malformed frame is received
+	ErrInvalidFrame = errors.New("invalid frame")
+	// ErrFrameTooLarge is returned for calls whose request or response exceeds MaxFrameSize
+	ErrFrameTooLarge = errors.New("frame too large")
+)
@@ -0,0 +1,143 @@
+package stdio
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/rollkit/go-execution/internal/errcode"
+)
+
+// Frame kinds.
+const (
+	kindInitChain byte = iota + 1
+	kindGetTxs
+	kindExecuteTxs
+	kindSetFinal
+	// kindCancel is sent by the client to cancel pending request; it has no payload.
+	kindCancel
+	// kindResponse carries protobuf encoded response of the request with the same ID.
+	kindResponse
+	// kindError carries error returned by executor for the request with the same ID.
+	kindError
+)
+
+// headerSize is the size of frame header following the length prefix: request ID and frame kind.
+const headerSize = 8 + 1
+
+// frame is a single message exchanged over stdio.
+//
+// On the wire, frame is encoded as 4 byte big-endian length of the rest of the frame, followed by
+// 8 byte big-endian request ID, 1 byte frame kind and payload. Payload of requests and responses is
+// protobuf encoded message from types/pb/execution. Payload of errors is 4 byte big-endian error code
+// (0 if error doesn't map to any sentinel error from types package) followed by error message.
+type frame struct {
+	id      uint64
+	kind    byte
+	payload []byte
+}
+
+func writeFrame(w io.Writer, f frame) error {
+	buf := make([]byte, 4+headerSize+len(f.payload))
+	binary.BigEndian.PutUint32(buf, uint32(headerSize+len(f.payload))) //nolint:gosec
+	binary.BigEndian.PutUint64(buf[4:], f.id)
+	buf[12] = f.kind
+	copy(buf[4+headerSize:], f.payload)
+	_, err := w.Write(buf)
+	return err
+}
+
+func readFrame(r *bufio.Reader, maxSize uint32) (frame, error) {
+	var length [4]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		return frame{}, err
+	}
+	size := binary.BigEndian.Uint32(length[:])
+	if size < headerSize {
+		return frame{}, fmt.Errorf("%w: frame too short (%d bytes)", ErrInvalidFrame, size)
+	}
+	if maxSize > 0 && size > maxSize {
+		return skipFrame(r, size)
+	}
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return frame{}, err
+	}
+	return frame{
+		id:      binary.BigEndian.Uint64(buf),
+		kind:    buf[8],
+		payload: buf[headerSize:],
+	}, nil
+}
+
+// skipFrame reads header of a frame exceeding the maximum size and discards its payload, so the stream stays
+// usable. It returns the frame without payload and error wrapping ErrFrameTooLarge.
+func skipFrame(r *bufio.Reader, size uint32) (frame, error) {
+	var header [headerSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return frame{}, io.ErrUnexpectedEOF
+	}
+	if _, err := io.CopyN(io.Discard, r, int64(size-headerSize)); err != nil {
+		return frame{}, io.ErrUnexpectedEOF
+	}
+	f := frame{
+		id:   binary.BigEndian.Uint64(header[:]),
+		kind: header[8],
+	}
+	return f, fmt.Errorf("%w: %d bytes", ErrFrameTooLarge, size)
+}
+
+// messageFrame encodes msg as the payload of a frame. It returns error wrapping ErrFrameTooLarge if the frame
+// would exceed MaxFrameSize.
+func messageFrame(id uint64, kind byte, msg proto.Message) (frame, error) {
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return frame{}, err
+	}
+	if size := headerSize + len(payload); size > MaxFrameSize {
+		return frame{}, fmt.Errorf("%w: %d bytes", ErrFrameTooLarge, size)
+	}
+	return frame{id: id, kind: kind, payload: payload}, nil
+}
+
+func errorFrame(id uint64, err error) frame {
+	code, _ := errcode.Code(err)
+	payload := make([]byte, 4+len(err.Error()))
+	binary.BigEndian.PutUint32(payload, uint32(code)) //nolint:gosec
+	copy(payload[4:], err.Error())
+	return frame{id: id, kind: kindError, payload: payload}
+}
+
+// Error is an error returned by the executor running in the child process.
+type Error struct {
+	Code    int
+	Message string
+}
+
+// Error implements error interface.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap returns sentinel error from types package corresponding to the error code, if any.
+// This enables usage of errors.Is on errors returned by Client.
+func (e *Error) Unwrap() error {
+	return errcode.Err(e.Code)
+}
+
+func decodeError(payload []byte) error {
+	if len(payload) < 4 {
+		return fmt.Errorf("%w: error frame too short", ErrInvalidFrame)
+	}
+	return &Error{
+		Code:    int(int32(binary.BigEndian.Uint32(payload))), //nolint:gosec
+		Message: string(payload[4:]),
+	}
+}
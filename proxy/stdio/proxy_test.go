@@ -0,0 +1,356 @@
+package stdio_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/rollkit/go-execution"
+	"github.com/rollkit/go-execution/proxy/stdio"
+	"github.com/rollkit/go-execution/test"
+	"github.com/rollkit/go-execution/types"
+	pb "github.com/rollkit/go-execution/types/pb/execution"
+)
+
+// childEnv selects behavior of the test binary re-executed as the child process.
+const childEnv = "STDIO_TEST_CHILD"
+
+// Block heights with special meaning for childExecutor.
+const (
+	crashHeight = 999
+	blockHeight = 998
+)
+
+func TestMain(m *testing.M) {
+	switch os.Getenv(childEnv) {
+	case "":
+		os.Exit(m.Run())
+	case "exit":
+		os.Exit(1)
+	default:
+		fmt.Fprintln(os.Stderr, "child ready")
+		if err := stdio.ServeStdio(context.Background(), &childExecutor{test.NewDummyExecutor()}); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		os.Exit(0)
+	}
+}
+
+// childExecutor crashes the process when finalizing crashHeight, and blocks until call is canceled when
+// executing blockHeight.
+type childExecutor struct {
+	*test.DummyExecutor
+}
+
+func (e *childExecutor) ExecuteTxs(ctx context.Context, txs []types.Tx, height uint64, timestamp time.Time, prevStateRoot types.Hash) (types.Hash, uint64, error) {
+	if height == blockHeight {
+		<-ctx.Done()
+		return nil, 0, ctx.Err()
+	}
+	return e.DummyExecutor.ExecuteTxs(ctx, txs, height, timestamp, prevStateRoot)
+}
+
+func (e *childExecutor) SetFinal(ctx context.Context, height uint64) error {
+	if height == crashHeight {
+		os.Exit(3)
+	}
+	return e.DummyExecutor.SetFinal(ctx, height)
+}
+
+func startClient(t *testing.T, mode string, config *stdio.Config) *stdio.Client {
+	t.Helper()
+	if config == nil {
+		config = stdio.DefaultConfig()
+	}
+	// race detector delays exit of the child process by 1s by default
+	config.Env = append(config.Env, childEnv+"="+mode, "GORACE=atexit_sleep_ms=0")
+	config.DefaultTimeout = 3 * time.Second
+	if config.Logger == nil {
+		config.Logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+	client := stdio.NewClient()
+	client.SetConfig(config)
+	require.NoError(t, client.Start(os.Args[0]))
+	t.Cleanup(func() {
+		require.NoError(t, client.Stop())
+	})
+	return client
+}
+
+type ProxyTestSuite struct {
+	test.ExecutorSuite
+}
+
+func (s *ProxyTestSuite) SetupTest() {
+	s.Exec = startClient(s.T(), "dummy", nil)
+}
+
+func TestProxySuite(t *testing.T) {
+	suite.Run(t, new(ProxyTestSuite))
+}
+
+func TestErrorSentinels(t *testing.T) {
+	client := startClient(t, "dummy", nil)
+	ctx := context.Background()
+
+	_, _, err := client.InitChain(ctx, time.Now().UTC(), 0, "test-chain")
+	assert.ErrorIs(t, err, types.ErrZeroInitialHeight)
+
+	_, _, err = client.ExecuteTxs(ctx, nil, 1, time.Now(), types.Hash{})
+	assert.ErrorIs(t, err, types.ErrEmptyStateRoot)
+
+	err = client.SetFinal(ctx, 123)
+	assert.ErrorIs(t, err, types.ErrBlockNotFound)
+	var stdioErr *stdio.Error
+	require.ErrorAs(t, err, &stdioErr)
+	assert.Equal(t, 3001, stdioErr.Code)
+}
+
+func TestCancel(t *testing.T) {
+	client := startClient(t, "dummy", nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, _, err := client.ExecuteTxs(ctx, nil, blockHeight, time.Now(), types.Hash{1})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, _, err = client.ExecuteTxs(context.Background(), nil, 1, time.Now(), types.Hash{1})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, client.Restarts())
+}
+
+func TestRestart(t *testing.T) {
+	var logs syncBuffer
+	config := stdio.DefaultConfig()
+	config.RestartDelay = 10 * time.Millisecond
+	config.Logger = slog.New(slog.NewTextHandler(&logs, nil))
+	client := startClient(t, "dummy", config)
+	ctx := context.Background()
+
+	_, _, err := client.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+
+	err = client.SetFinal(ctx, crashHeight)
+	assert.ErrorIs(t, err, stdio.ErrProcessExited)
+
+	// call waits until the process is restarted
+	_, _, err = client.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.Restarts())
+
+	assert.Contains(t, logs.String(), "child process exited")
+	assert.Contains(t, logs.String(), "child process restarted")
+}
+
+func TestStderrForwarding(t *testing.T) {
+	var logs syncBuffer
+	config := stdio.DefaultConfig()
+	config.Logger = slog.New(slog.NewTextHandler(&logs, nil))
+	client := startClient(t, "dummy", config)
+
+	// response to a call guarantees that the child process finished its startup
+	_, err := client.GetTxs(context.Background())
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return bytes.Contains([]byte(logs.String()), []byte("msg=\"child ready\""))
+	}, time.Second, 10*time.Millisecond)
+	assert.Contains(t, logs.String(), "process=")
+}
+
+func TestMaxRestarts(t *testing.T) {
+	config := stdio.DefaultConfig()
+	config.RestartDelay = time.Millisecond
+	config.MaxRestarts = 2
+	client := startClient(t, "exit", config)
+
+	// calls fail with ErrProcessExited until supervisor gives up
+	require.Eventually(t, func() bool {
+		_, err := client.GetTxs(context.Background())
+		return errors.Is(err, stdio.ErrTooManyRestarts)
+	}, 3*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 2, client.Restarts())
+}
+
+func TestStartError(t *testing.T) {
+	client := stdio.NewClient()
+	assert.Error(t, client.Start("/nonexistent/executor"))
+
+	_, err := client.GetTxs(context.Background())
+	assert.ErrorIs(t, err, stdio.ErrClientNotStarted)
+}
+
+func TestStop(t *testing.T) {
+	client := stdio.NewClient()
+	client.SetConfig(&stdio.Config{Env: []string{childEnv + "=dummy"}, ShutdownTimeout: time.Second})
+	require.NoError(t, client.Start(os.Args[0]))
+	require.NoError(t, client.Stop())
+	require.NoError(t, client.Stop())
+
+	_, err := client.GetTxs(context.Background())
+	assert.ErrorIs(t, err, stdio.ErrClientStopped)
+}
+
+func TestRequestTooLarge(t *testing.T) {
+	client := startClient(t, "dummy", nil)
+	ctx := context.Background()
+
+	tx := make(types.Tx, stdio.MaxFrameSize)
+	_, _, err := client.ExecuteTxs(ctx, []types.Tx{tx}, 1, time.Now(), types.Hash{1})
+	assert.ErrorIs(t, err, stdio.ErrFrameTooLarge)
+
+	// only the call failed
+	_, _, err = client.ExecuteTxs(ctx, nil, 1, time.Now(), types.Hash{1})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, client.Restarts())
+}
+
+// Frame kinds, see frame.go.
+const (
+	kindGetTxs     = 2
+	kindExecuteTxs = 3
+	kindSetFinal   = 4
+	kindResponse   = 6
+	kindError      = 7
+)
+
+// serve runs Serve with exec, returning writer of requests and reader of responses.
+func serve(t *testing.T, exec execution.Executor) (io.Writer, io.Reader) {
+	t.Helper()
+	reqR, reqW := io.Pipe()
+	respR, respW := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		done <- stdio.Serve(context.Background(), exec, reqR, respW)
+	}()
+	t.Cleanup(func() {
+		require.NoError(t, reqW.Close())
+		go func() { _, _ = io.Copy(io.Discard, respR) }()
+		require.NoError(t, <-done)
+	})
+	return reqW, respR
+}
+
+// writeFrame writes a frame with given length prefix, which may differ from the actual size.
+func writeFrame(w io.Writer, id uint64, kind byte, size uint32, payload []byte) error {
+	header := make([]byte, 13)
+	binary.BigEndian.PutUint32(header, size)
+	binary.BigEndian.PutUint64(header[4:], id)
+	header[12] = kind
+	_, err := w.Write(append(header, payload...))
+	return err
+}
+
+func writeRequest(w io.Writer, id uint64, kind byte, req proto.Message) error {
+	payload, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	return writeFrame(w, id, kind, uint32(9+len(payload)), payload) //nolint:gosec
+}
+
+func readFrame(t *testing.T, r io.Reader) (uint64, byte, []byte) {
+	t.Helper()
+	var length [4]byte
+	_, err := io.ReadFull(r, length[:])
+	require.NoError(t, err)
+	buf := make([]byte, binary.BigEndian.Uint32(length[:]))
+	_, err = io.ReadFull(r, buf)
+	require.NoError(t, err)
+	return binary.BigEndian.Uint64(buf), buf[8], buf[9:]
+}
+
+// slowExecutor executes blocks slowly, recording executed and finalized heights.
+type slowExecutor struct {
+	*test.DummyExecutor
+	mu     sync.Mutex
+	events []string
+}
+
+func (e *slowExecutor) ExecuteTxs(ctx context.Context, txs []types.Tx, height uint64, timestamp time.Time, prevStateRoot types.Hash) (types.Hash, uint64, error) {
+	time.Sleep(50 * time.Millisecond)
+	e.record(fmt.Sprintf("execute %d", height))
+	return e.DummyExecutor.ExecuteTxs(ctx, txs, height, timestamp, prevStateRoot)
+}
+
+func (e *slowExecutor) SetFinal(ctx context.Context, height uint64) error {
+	e.record(fmt.Sprintf("final %d", height))
+	return e.DummyExecutor.SetFinal(ctx, height)
+}
+
+func (e *slowExecutor) record(event string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.events = append(e.events, event)
+}
+
+func TestServeOrder(t *testing.T) {
+	exec := &slowExecutor{DummyExecutor: test.NewDummyExecutor()}
+	w, r := serve(t, exec)
+
+	// SetFinal is sent before the block is executed, but handled after it
+	require.NoError(t, writeRequest(w, 1, kindExecuteTxs, &pb.ExecuteTxsRequest{BlockHeight: 1, PrevStateRoot: []byte{1}}))
+	require.NoError(t, writeRequest(w, 2, kindSetFinal, &pb.SetFinalRequest{BlockHeight: 1}))
+	for _, expected := range []uint64{1, 2} {
+		id, kind, payload := readFrame(t, r)
+		assert.Equal(t, expected, id)
+		assert.Equal(t, byte(kindResponse), kind, string(payload))
+	}
+	assert.Equal(t, []string{"execute 1", "final 1"}, exec.events)
+}
+
+func TestServeFrameTooLarge(t *testing.T) {
+	w, r := serve(t, test.NewDummyExecutor())
+
+	written := make(chan error, 1)
+	go func() {
+		err := writeFrame(w, 1, kindGetTxs, stdio.MaxFrameSize+1, make([]byte, stdio.MaxFrameSize+1-9))
+		if err == nil {
+			err = writeRequest(w, 2, kindGetTxs, &pb.GetTxsRequest{})
+		}
+		written <- err
+	}()
+
+	// oversized call fails, and serving continues
+	id, kind, payload := readFrame(t, r)
+	assert.Equal(t, uint64(1), id)
+	assert.Equal(t, byte(kindError), kind)
+	assert.Contains(t, string(payload), stdio.ErrFrameTooLarge.Error())
+
+	id, kind, _ = readFrame(t, r)
+	assert.Equal(t, uint64(2), id)
+	assert.Equal(t, byte(kindResponse), kind)
+	require.NoError(t, <-written)
+}
+
+// syncBuffer is a bytes.Buffer safe for concurrent use.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
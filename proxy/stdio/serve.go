@@ -0,0 +1,217 @@
+package stdio
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/rollkit/go-execution"
+	"github.com/rollkit/go-execution/types"
+	pb "github.com/rollkit/go-execution/types/pb/execution"
+)
+
+// ServeStdio serves Execution API calls received on standard input, writing responses to standard output.
+// It's intended to be called from main function of the child process. Executor must not write to
+// standard output; standard error can be used for logging.
+//
+// ServeStdio returns nil when standard input is closed by the parent process.
+func ServeStdio(ctx context.Context, exec execution.Executor) error {
+	return Serve(ctx, exec, os.Stdin, os.Stdout)
+}
+
+// Serve serves Execution API calls read from r, writing responses to w. Calls are handled one at a time, in the
+// order they were received, as executors expect calls in the order they were made (e.g. SetFinal after
+// ExecuteTxs of the block); cancellation of a call is handled immediately, also while it's waiting for previous
+// calls. Calls exceeding MaxFrameSize fail with an error, without being handled.
+//
+// Serve returns nil when r is closed, after all pending calls are completed. If ctx is canceled, contexts
+// of pending calls are canceled and ctx error is returned.
+func Serve(ctx context.Context, exec execution.Executor, r io.Reader, w io.Writer) error {
+	s := &server{
+		exec:    exec,
+		w:       w,
+		pending: make(map[uint64]context.CancelFunc),
+	}
+	return s.serve(ctx, bufio.NewReader(r))
+}
+
+type server struct {
+	exec execution.Executor
+
+	writeMu sync.Mutex
+	w       io.Writer
+
+	mu      sync.Mutex
+	pending map[uint64]context.CancelFunc
+	wg      sync.WaitGroup
+
+	// last is closed when the last dispatched call is completed; it's used only by the serving loop
+	last chan struct{}
+}
+
+func (s *server) serve(ctx context.Context, r *bufio.Reader) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer s.wg.Wait()
+
+	frames := make(chan frame)
+	readErr := make(chan error, 1)
+	go func() {
+		for {
+			f, err := readFrame(r, MaxFrameSize)
+			if errors.Is(err, ErrFrameTooLarge) {
+				if f.kind != kindCancel {
+					s.write(errorFrame(f.id, err))
+				}
+				continue
+			}
+			if err != nil {
+				readErr <- err
+				return
+			}
+			select {
+			case frames <- f:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-readErr:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		case f := <-frames:
+			s.dispatch(ctx, f)
+		}
+	}
+}
+
+func (s *server) dispatch(ctx context.Context, f frame) {
+	if f.kind == kindCancel {
+		s.mu.Lock()
+		if cancel, ok := s.pending[f.id]; ok {
+			cancel()
+		}
+		s.mu.Unlock()
+		return
+	}
+
+	callCtx, cancel := context.WithCancel(ctx)
+	s.mu.Lock()
+	s.pending[f.id] = cancel
+	s.mu.Unlock()
+
+	prev := s.last
+	done := make(chan struct{})
+	s.last = done
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer close(done)
+		defer func() {
+			s.mu.Lock()
+			delete(s.pending, f.id)
+			s.mu.Unlock()
+			cancel()
+		}()
+
+		if prev != nil {
+			<-prev
+		}
+		resp, err := s.handleCall(callCtx, f)
+		var out frame
+		if err == nil {
+			out, err = messageFrame(f.id, kindResponse, resp)
+		}
+		if err != nil {
+			out = errorFrame(f.id, err)
+		}
+		s.write(out)
+	}()
+}
+
+func (s *server) write(f frame) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	// write errors mean that parent process is gone; reading loop will terminate on EOF
+	_ = writeFrame(s.w, f)
+}
+
+// handleCall handles the call unless it was canceled while waiting for previous calls.
+func (s *server) handleCall(ctx context.Context, f frame) (proto.Message, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.handle(ctx, f)
+}
+
+func (s *server) handle(ctx context.Context, f frame) (proto.Message, error) {
+	switch f.kind {
+	case kindInitChain:
+		var req pb.InitChainRequest
+		if err := proto.Unmarshal(f.payload, &req); err != nil {
+			return nil, err
+		}
+		stateRoot, maxBytes, err := s.exec.InitChain(ctx, time.Unix(req.GenesisTime, 0).UTC(), req.InitialHeight, req.ChainId)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.InitChainResponse{StateRoot: stateRoot, MaxBytes: maxBytes}, nil
+	case kindGetTxs:
+		var req pb.GetTxsRequest
+		if err := proto.Unmarshal(f.payload, &req); err != nil {
+			return nil, err
+		}
+		txs, err := execution.GetTxsWithLimits(ctx, s.exec, types.TxLimits{
+			MaxBytes: req.MaxBytes,
+			MaxTxs:   req.MaxTxs,
+			MaxGas:   req.MaxGas,
+		})
+		if err != nil {
+			return nil, err
+		}
+		pbTxs := make([][]byte, len(txs))
+		for i, tx := range txs {
+			pbTxs[i] = tx
+		}
+		return &pb.GetTxsResponse{Txs: pbTxs}, nil
+	case kindExecuteTxs:
+		var req pb.ExecuteTxsRequest
+		if err := proto.Unmarshal(f.payload, &req); err != nil {
+			return nil, err
+		}
+		txs := make([]types.Tx, len(req.Txs))
+		for i, tx := range req.Txs {
+			txs[i] = tx
+		}
+		stateRoot, maxBytes, err := s.exec.ExecuteTxs(ctx, txs, req.BlockHeight, time.Unix(req.Timestamp, 0), req.PrevStateRoot)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.ExecuteTxsResponse{UpdatedStateRoot: stateRoot, MaxBytes: maxBytes}, nil
+	case kindSetFinal:
+		var req pb.SetFinalRequest
+		if err := proto.Unmarshal(f.payload, &req); err != nil {
+			return nil, err
+		}
+		if err := s.exec.SetFinal(ctx, req.BlockHeight); err != nil {
+			return nil, err
+		}
+		return &pb.SetFinalResponse{}, nil
+	}
+	return nil, fmt.Errorf("%w: unknown frame kind %d", ErrInvalidFrame, f.kind)
+}
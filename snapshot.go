@@ -0,0 +1,96 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rollkit/go-execution/types"
+)
+
+// SnapshotProvider is an optional interface that can be implemented by an Executor to serve snapshots of its
+// state, so new nodes can be restored without replaying blocks from genesis.
+type SnapshotProvider interface {
+	// ListSnapshots returns available snapshots.
+	// Requirements:
+	// - Must only return snapshots of finalized state
+	// - Must return snapshots whose chunks can be loaded with LoadSnapshotChunk
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	//
+	// Returns:
+	// - []types.Snapshot: Available snapshots, most recent first
+	// - error: Any errors during listing
+	ListSnapshots(ctx context.Context) ([]types.Snapshot, error)
+
+	// LoadSnapshotChunk returns a chunk of the snapshot.
+	// Requirements:
+	// - Must return types.ErrSnapshotNotFound if the snapshot or chunk doesn't exist
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - height: Height of the snapshot
+	// - format: Format of the snapshot
+	// - index: Index of the chunk, from 0 to Chunks-1
+	//
+	// Returns:
+	// - []byte: Contents of the chunk
+	// - error: Any errors during loading
+	LoadSnapshotChunk(ctx context.Context, height uint64, format uint32, index uint32) ([]byte, error)
+}
+
+// SnapshotRestorer is an optional interface that can be implemented by an Executor to restore its state from
+// a snapshot served by a SnapshotProvider, instead of InitChain.
+type SnapshotRestorer interface {
+	// OfferSnapshot starts restoration of the snapshot.
+	// Requirements:
+	// - Must return error wrapping types.ErrSnapshotRejected if the snapshot can't be restored, e.g. due to
+	//   unsupported format or already initialized chain
+	// - Must abort restoration of previously offered snapshot
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - snapshot: Snapshot to restore
+	//
+	// Returns:
+	// - error: Any errors during validation of the snapshot
+	OfferSnapshot(ctx context.Context, snapshot types.Snapshot) error
+
+	// ApplySnapshotChunk applies a chunk of the offered snapshot.
+	// Requirements:
+	// - Must accept chunks in order, starting from 0
+	// - Must return error wrapping types.ErrInvalidSnapshotChunk if the chunk is unexpected or the restored
+	//   state doesn't match the snapshot; restoration must be started again with OfferSnapshot
+	// - Must initialize the chain with state of the snapshot after the last chunk is applied
+	//
+	// Parameters:
+	// - ctx: Context for timeout/cancellation control
+	// - index: Index of the chunk
+	// - chunk: Contents of the chunk
+	//
+	// Returns:
+	// - done: True if the last chunk was applied and the state is restored
+	// - err: Any errors during applying the chunk
+	ApplySnapshotChunk(ctx context.Context, index uint32, chunk []byte) (done bool, err error)
+}
+
+// RestoreSnapshot restores snapshot served by provider into restorer, applying its chunks one by one.
+func RestoreSnapshot(ctx context.Context, provider SnapshotProvider, restorer SnapshotRestorer, snapshot types.Snapshot) error {
+	if err := restorer.OfferSnapshot(ctx, snapshot); err != nil {
+		return fmt.Errorf("offer snapshot at height %d: %w", snapshot.Height, err)
+	}
+	for index := uint32(0); index < snapshot.Chunks; index++ {
+		chunk, err := provider.LoadSnapshotChunk(ctx, snapshot.Height, snapshot.Format, index)
+		if err != nil {
+			return fmt.Errorf("load snapshot chunk %d: %w", index, err)
+		}
+		done, err := restorer.ApplySnapshotChunk(ctx, index, chunk)
+		if err != nil {
+			return fmt.Errorf("apply snapshot chunk %d: %w", index, err)
+		}
+		if done {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: snapshot not restored after %d chunks", types.ErrInvalidSnapshotChunk, snapshot.Chunks)
+}
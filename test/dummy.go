@@ -4,11 +4,14 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha512"
+	"fmt"
 	"regexp"
 	"slices"
 	"sync"
 	"time"
 
+	"github.com/rollkit/go-execution"
+	"github.com/rollkit/go-execution/mempool"
 	"github.com/rollkit/go-execution/types"
 )
 
@@ -16,22 +19,48 @@ var validChainIDRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]*`)
 
 // DummyExecutor is a dummy implementation of the DummyExecutor interface for testing
 type DummyExecutor struct {
-	mu           sync.RWMutex
-	stateRoot    types.Hash
-	pendingRoots map[uint64]types.Hash
-	maxBytes     uint64
-	injectedTxs  []types.Tx
+	mu            sync.RWMutex
+	stateRoot     types.Hash
+	pendingBlocks map[pendingKey]*pendingBlock
+	pendingSeq    uint64
+	maxBytes      uint64
+	injectedTxs   []types.Tx
+	// mempool replaces injectedTxs if set, see NewDummyExecutorWithMempool
+	mempool     *mempool.Mempool
+	initialized bool
+
+	finalHeight  uint64
+	finalRoots   map[uint64]types.Hash
+	retainHeight uint64
+	snapshots    []dummySnapshot
+	restore      *dummyRestore
 }
 
-// NewDummyExecutor creates a new dummy DummyExecutor instance
+// NewDummyExecutor creates a new dummy DummyExecutor instance. Injected transactions are kept in a list,
+// in order of injection and including duplicates, until they are executed.
 func NewDummyExecutor() *DummyExecutor {
 	return &DummyExecutor{
-		stateRoot:    types.Hash{1, 2, 3},
-		pendingRoots: make(map[uint64]types.Hash),
-		maxBytes:     1000000,
+		stateRoot:     types.Hash{1, 2, 3},
+		pendingBlocks: make(map[pendingKey]*pendingBlock),
+		finalRoots:    make(map[uint64]types.Hash),
+		maxBytes:      1000000,
 	}
 }
 
+// NewDummyExecutorWithMempool creates a new dummy DummyExecutor instance keeping injected transactions in given
+// mempool, e.g. one persisted with mempool.Open. Transactions rejected by the mempool (e.g. duplicates) are
+// ignored, and GetTxs returns only transactions fitting into a block.
+func NewDummyExecutorWithMempool(mp *mempool.Mempool) *DummyExecutor {
+	e := NewDummyExecutor()
+	e.mempool = mp
+	return e
+}
+
+// Describe returns name and version of DummyExecutor.
+func (e *DummyExecutor) Describe() (string, string) {
+	return "dummy", "v1.0.0"
+}
+
 // InitChain initializes the chain state with the given genesis time, initial height, and chain ID.
 // It returns the state root hash, the maximum byte size, and an error if the initialization fails.
 func (e *DummyExecutor) InitChain(ctx context.Context, genesisTime time.Time, initialHeight uint64, chainID string) (types.Hash, uint64, error) {
@@ -57,51 +86,207 @@ func (e *DummyExecutor) InitChain(ctx context.Context, genesisTime time.Time, in
 	hash := sha512.New()
 	hash.Write(e.stateRoot)
 	e.stateRoot = hash.Sum(nil)
+	e.initialized = true
+	e.finalHeight = initialHeight - 1
+	e.finalRoots[e.finalHeight] = e.stateRoot
 	return e.stateRoot, e.maxBytes, nil
 }
 
+// CheckHealth reports DummyExecutor as ready once the chain was initialized.
+func (e *DummyExecutor) CheckHealth(context.Context) error {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if !e.initialized {
+		return types.ErrChainNotInitialized
+	}
+	return nil
+}
+
 // GetTxs returns the list of transactions (types.Tx) within the DummyExecutor instance and an error if any.
 func (e *DummyExecutor) GetTxs(context.Context) ([]types.Tx, error) {
 	e.mu.RLock()
 	defer e.mu.RUnlock()
 
+	if e.mempool != nil {
+		return e.mempool.Reap(e.maxBytes), nil
+	}
 	txs := make([]types.Tx, len(e.injectedTxs))
 	copy(txs, e.injectedTxs)
 	return txs, nil
 }
 
-// InjectTx adds a transaction to the internal list of injected transactions in the DummyExecutor instance.
+// GetTxsInfo returns the same transactions as GetTxs with their hash, priority and size, and an error if any.
+// DummyExecutor doesn't know senders and nonces of transactions.
+func (e *DummyExecutor) GetTxsInfo(ctx context.Context) ([]types.TxInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if e.mempool != nil {
+		return e.mempool.ReapInfo(types.TxLimits{MaxBytes: e.maxBytes}), nil
+	}
+	infos := make([]types.TxInfo, len(e.injectedTxs))
+	for i, tx := range e.injectedTxs {
+		infos[i] = types.NewTxInfo(tx)
+	}
+	return infos, nil
+}
+
+// GetTxsWithLimits returns transactions within the DummyExecutor instance that fit into given limits, and an
+// error if any. Transactions never exceed the maxBytes of a block; DummyExecutor has no notion of gas.
+func (e *DummyExecutor) GetTxsWithLimits(ctx context.Context, limits types.TxLimits) ([]types.Tx, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if limits.MaxBytes == 0 || limits.MaxBytes > e.maxBytes {
+		limits.MaxBytes = e.maxBytes
+	}
+	if e.mempool != nil {
+		return e.mempool.ReapWithLimits(limits), nil
+	}
+	return limits.Trim(slices.Clone(e.injectedTxs)), nil
+}
+
+// GetTxsPage returns a page of transactions within the DummyExecutor instance and an error if any.
+// Unlike GetTxs, pages cover the whole mempool, not only transactions fitting into a block. Without mempool,
+// pages are cut from transactions returned by GetTxs, like execution.GetTxsPage does.
+func (e *DummyExecutor) GetTxsPage(ctx context.Context, pageToken []byte, limits types.TxLimits) ([]types.Tx, []byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if e.mempool == nil {
+		return execution.GetTxsPage(ctx, struct{ execution.Executor }{e}, pageToken, limits)
+	}
+	return e.mempool.ReapPage(pageToken, limits)
+}
+
+// InjectTx adds a transaction to the DummyExecutor instance.
 func (e *DummyExecutor) InjectTx(tx types.Tx) {
+	if e.mempool != nil {
+		_ = e.mempool.Add(tx)
+		return
+	}
+
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
 	e.injectedTxs = append(e.injectedTxs, tx)
 }
 
-// ExecuteTxs simulate execution of transactions.
+// ExecuteTxs simulate execution of transactions. Once the chain is initialized, prevStateRoot must be the state
+// root of a finalized or pending block at blockHeight-1, otherwise types.ErrBlockNotFound is returned.
 func (e *DummyExecutor) ExecuteTxs(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot types.Hash) (types.Hash, uint64, error) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
+	pending, err := e.execute(txs, blockHeight, timestamp, prevStateRoot)
+	if err != nil {
+		return types.Hash{}, 0, err
+	}
+	e.addPending(blockHeight, prevStateRoot, pending)
+	e.removeExecutedTxs(blockHeight, txs)
+	return pending, e.maxBytes, nil
+}
+
+// ExecuteBlocks executes blocks in order, like consecutive calls of ExecuteTxs.
+func (e *DummyExecutor) ExecuteBlocks(ctx context.Context, blocks []types.BatchBlock, prevStateRoot types.Hash) ([]types.Hash, uint64, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	stateRoots := make([]types.Hash, 0, len(blocks))
+	for i, block := range blocks {
+		if err := ctx.Err(); err != nil {
+			return stateRoots, e.maxBytes, &types.BatchError{Index: i, Err: err}
+		}
+		stateRoot, err := e.execute(block.Txs, block.Height, block.Timestamp, prevStateRoot)
+		if err != nil {
+			return stateRoots, e.maxBytes, &types.BatchError{Index: i, Err: err}
+		}
+		e.addPending(block.Height, prevStateRoot, stateRoot)
+		e.removeExecutedTxs(block.Height, block.Txs)
+		stateRoots = append(stateRoots, stateRoot)
+		prevStateRoot = stateRoot
+	}
+	return stateRoots, e.maxBytes, nil
+}
+
+// VerifyBlock executes transactions like ExecuteTxs if the resulting state root equals expectedRoot.
+func (e *DummyExecutor) VerifyBlock(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot, expectedRoot types.Hash) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	stateRoot, err := e.execute(txs, blockHeight, timestamp, prevStateRoot)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(stateRoot, expectedRoot) {
+		return &types.StateRootMismatchError{Height: blockHeight, Expected: expectedRoot, Actual: stateRoot}
+	}
+	e.addPending(blockHeight, prevStateRoot, stateRoot)
+	e.removeExecutedTxs(blockHeight, txs)
+	return nil
+}
+
+// SimulateTxs returns the state root ExecuteTxs would return, without registering pending block.
+// DummyExecutor never rejects individual transactions and has no notion of gas.
+func (e *DummyExecutor) SimulateTxs(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot types.Hash) (*types.SimulationResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	stateRoot, err := e.execute(txs, blockHeight, timestamp, prevStateRoot)
+	if err != nil {
+		return nil, err
+	}
+	return &types.SimulationResult{StateRoot: stateRoot, MaxBytes: e.maxBytes}, nil
+}
+
+func (e *DummyExecutor) removeExecutedTxs(blockHeight uint64, txs []types.Tx) {
+	if e.mempool != nil {
+		e.mempool.Update(blockHeight, txs)
+		return
+	}
+	e.injectedTxs = slices.DeleteFunc(e.injectedTxs, func(tx types.Tx) bool {
+		return slices.ContainsFunc(txs, func(t types.Tx) bool { return bytes.Equal(tx, t) })
+	})
+}
+
+// execute validates the block and returns its state root.
+func (e *DummyExecutor) execute(txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot types.Hash) (types.Hash, error) {
 	if bytes.Equal(prevStateRoot, types.Hash{}) {
-		return types.Hash{}, 0, types.ErrEmptyStateRoot
+		return types.Hash{}, types.ErrEmptyStateRoot
 	}
 
 	// Don't really allow future block times, but allow up to 5 minutes in the future
 	// for testing purposes.
 	if timestamp.After(time.Now().Add(5 * time.Minute)) {
-		return types.Hash{}, 0, types.ErrFutureBlockTime
+		return types.Hash{}, types.ErrFutureBlockTime
 	}
 	if blockHeight == 0 {
-		return types.Hash{}, 0, types.ErrInvalidBlockHeight
+		return types.Hash{}, types.ErrInvalidBlockHeight
+	}
+	if !e.knownParent(blockHeight-1, prevStateRoot) {
+		return types.Hash{}, fmt.Errorf("%w: no block with state root %X at height %d", types.ErrBlockNotFound, prevStateRoot, blockHeight-1)
 	}
 
 	for _, tx := range txs {
 		if len(tx) == 0 {
-			return types.Hash{}, 0, types.ErrEmptyTx
+			return types.Hash{}, types.ErrEmptyTx
 		}
 		if uint64(len(tx)) > e.maxBytes {
-			return types.Hash{}, 0, types.ErrTxTooLarge
+			return types.Hash{}, types.ErrTxTooLarge
 		}
 	}
 
@@ -110,29 +295,136 @@ func (e *DummyExecutor) ExecuteTxs(ctx context.Context, txs []types.Tx, blockHei
 	for _, tx := range txs {
 		hash.Write(tx)
 	}
-	pending := hash.Sum(nil)
-	e.pendingRoots[blockHeight] = pending
-	e.removeExecutedTxs(txs)
-	return pending, e.maxBytes, nil
+	return hash.Sum(nil), nil
 }
 
-// SetFinal marks block at given height as finalized.
+// SetFinal marks block at given height as finalized, together with pending blocks below it the block descends
+// from. If multiple blocks were executed at the height, the one chained from the last finalized block is
+// finalized; types.ErrBlockNotFound is returned if there is none. Pending blocks not descending from the
+// finalized block are discarded. Finalizing already finalized height has no effect.
 func (e *DummyExecutor) SetFinal(ctx context.Context, blockHeight uint64) error {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	if pending, ok := e.pendingRoots[blockHeight]; ok {
-		e.stateRoot = pending
-		delete(e.pendingRoots, blockHeight)
+	return e.setFinal(blockHeight)
+}
+
+// SetFinalRange marks blocks from fromHeight to toHeight as finalized, like SetFinal called for every height.
+func (e *DummyExecutor) SetFinalRange(ctx context.Context, fromHeight, toHeight uint64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if fromHeight == 0 || fromHeight > toHeight {
+		return fmt.Errorf("%w: invalid range %d-%d", types.ErrInvalidBlockHeight, fromHeight, toHeight)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for height := fromHeight; ; height++ {
+		if err := e.setFinal(height); err != nil {
+			return fmt.Errorf("set final %d: %w", height, err)
+		}
+		if height == toHeight {
+			return nil
+		}
+	}
+}
+
+// setFinal finalizes block at given height. Caller must hold the lock.
+func (e *DummyExecutor) setFinal(blockHeight uint64) error {
+	if e.initialized && blockHeight <= e.finalHeight {
 		return nil
 	}
-	return types.ErrBlockNotFound
+	// every height between the finalized block and the block is finalized with it
+	chain := e.pendingChain(blockHeight)
+	if len(chain) == 0 {
+		return types.ErrBlockNotFound
+	}
+	for _, block := range chain {
+		e.finalRoots[block.height] = block.root
+	}
+	final := chain[len(chain)-1]
+	e.stateRoot = final.root
+	e.finalHeight = blockHeight
+	e.discardBranches(final)
+	e.takeSnapshot()
+	return nil
 }
 
-func (e *DummyExecutor) removeExecutedTxs(txs []types.Tx) {
-	e.injectedTxs = slices.DeleteFunc(e.injectedTxs, func(tx types.Tx) bool {
-		return slices.ContainsFunc(txs, func(t types.Tx) bool { return bytes.Equal(tx, t) })
-	})
+// Prune removes state roots of finalized blocks, and pending blocks, below retainHeight. State roots of finalized
+// blocks at multiples of keepEvery are kept.
+func (e *DummyExecutor) Prune(ctx context.Context, retainHeight, keepEvery uint64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if retainHeight > e.finalHeight {
+		return fmt.Errorf("%w: retain height %d is above last finalized height %d", types.ErrInvalidBlockHeight, retainHeight, e.finalHeight)
+	}
+	if retainHeight <= e.retainHeight {
+		return nil
+	}
+	for height := range e.finalRoots {
+		if height < retainHeight && (keepEvery == 0 || height%keepEvery != 0) {
+			delete(e.finalRoots, height)
+		}
+	}
+	for key := range e.pendingBlocks {
+		if key.height < retainHeight {
+			delete(e.pendingBlocks, key)
+		}
+	}
+	e.retainHeight = retainHeight
+	return nil
+}
+
+// StateRootAt returns the state root after the block at given height, and whether the block is finalized.
+// If multiple blocks are pending at the height, the one SetFinal would pick is used.
+func (e *DummyExecutor) StateRootAt(ctx context.Context, height uint64) (types.Hash, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, false, err
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if stateRoot, ok := e.finalRoots[height]; ok {
+		return stateRoot, true, nil
+	}
+	if block := e.pendingAt(height); block != nil {
+		return block.root, false, nil
+	}
+	if height < e.retainHeight {
+		return nil, false, fmt.Errorf("%w: height %d was pruned", types.ErrBlockNotFound, height)
+	}
+	return nil, false, fmt.Errorf("%w: height %d", types.ErrBlockNotFound, height)
+}
+
+// ChainStatus returns the latest executed and finalized heights.
+func (e *DummyExecutor) ChainStatus(ctx context.Context) (*types.ChainStatus, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if !e.initialized {
+		return nil, types.ErrChainNotInitialized
+	}
+	latestHeight := e.finalHeight
+	for key := range e.pendingBlocks {
+		latestHeight = max(latestHeight, key.height)
+	}
+	return &types.ChainStatus{
+		LatestHeight:       latestHeight,
+		FinalizedHeight:    e.finalHeight,
+		FinalizedStateRoot: e.stateRoot,
+	}, nil
 }
 
 // GetStateRoot returns the current state root in a thread-safe manner
@@ -0,0 +1,147 @@
+package test
+
+import (
+	"bytes"
+	"cmp"
+	"slices"
+	"sort"
+
+	"github.com/rollkit/go-execution/types"
+)
+
+// pendingKey identifies a pending block by its height and the state root it was executed on, so competing
+// blocks at the same height can coexist until one of them is finalized.
+type pendingKey struct {
+	height   uint64
+	prevRoot string
+}
+
+// pendingBlock is an executed block waiting for finalization.
+type pendingBlock struct {
+	height   uint64
+	prevRoot types.Hash
+	root     types.Hash
+	// seq orders blocks by execution, so the most recently executed candidate can be preferred.
+	seq uint64
+}
+
+// addPending registers executed block, replacing the block executed at the same height on the same state root.
+// Caller must hold the lock.
+func (e *DummyExecutor) addPending(height uint64, prevRoot, root types.Hash) {
+	e.pendingSeq++
+	e.pendingBlocks[pendingKey{height: height, prevRoot: string(prevRoot)}] = &pendingBlock{
+		height:   height,
+		prevRoot: prevRoot,
+		root:     root,
+		seq:      e.pendingSeq,
+	}
+}
+
+// pendingAt returns the candidate block at given height: the one chained through candidates at lower heights
+// from the last finalized block, or nil if there is none. Caller must hold the lock.
+func (e *DummyExecutor) pendingAt(height uint64) *pendingBlock {
+	chain := e.pendingChain(height)
+	if len(chain) == 0 {
+		return nil
+	}
+	return chain[len(chain)-1]
+}
+
+// pendingChain returns pending blocks at every height above the last finalized block up to given height, the
+// first executed on top of the finalized block and every next one on top of the previous one, or nil if there
+// is a gap. Before the chain is initialized nothing is finalized, so the chain ends with the most recently
+// executed candidate at the height and starts with its lowest pending ancestor. Caller must hold the lock.
+func (e *DummyExecutor) pendingChain(height uint64) []*pendingBlock {
+	if !e.initialized {
+		var latest *pendingBlock
+		for _, block := range e.pendingBlocks {
+			if block.height == height && (latest == nil || block.seq > latest.seq) {
+				latest = block
+			}
+		}
+		if latest == nil {
+			return nil
+		}
+		chain := append(e.ancestors(latest), latest)
+		slices.SortFunc(chain, func(a, b *pendingBlock) int { return cmp.Compare(a.height, b.height) })
+		return chain
+	}
+
+	var chain []*pendingBlock
+	root := e.finalRoots[e.finalHeight]
+	for h := e.finalHeight + 1; h <= height; h++ {
+		block, ok := e.pendingBlocks[pendingKey{height: h, prevRoot: string(root)}]
+		if !ok {
+			return nil
+		}
+		chain = append(chain, block)
+		root = block.root
+	}
+	return chain
+}
+
+// knownParent reports whether prevStateRoot is the state root of a finalized or pending block at given height.
+// Before the chain is initialized, any state root is accepted. Caller must hold the lock.
+func (e *DummyExecutor) knownParent(height uint64, prevStateRoot types.Hash) bool {
+	if !e.initialized {
+		return true
+	}
+	if root, ok := e.finalRoots[height]; ok && bytes.Equal(root, prevStateRoot) {
+		return true
+	}
+	for _, block := range e.pendingBlocks {
+		if block.height == height && bytes.Equal(block.root, prevStateRoot) {
+			return true
+		}
+	}
+	return false
+}
+
+// ancestors returns pending blocks the block descends from, from the highest one down to the first block
+// without pending parent. Caller must hold the lock.
+func (e *DummyExecutor) ancestors(block *pendingBlock) []*pendingBlock {
+	var result []*pendingBlock
+	for {
+		var parent *pendingBlock
+		for _, candidate := range e.pendingBlocks {
+			if candidate.height == block.height-1 && bytes.Equal(candidate.root, block.prevRoot) {
+				parent = candidate
+				break
+			}
+		}
+		if parent == nil {
+			return result
+		}
+		result = append(result, parent)
+		block = parent
+	}
+}
+
+// discardBranches removes pending blocks at or below the finalized block, and blocks above it not descending
+// from it. Caller must hold the lock.
+func (e *DummyExecutor) discardBranches(final *pendingBlock) {
+	blocks := make([]*pendingBlock, 0, len(e.pendingBlocks))
+	for _, block := range e.pendingBlocks {
+		blocks = append(blocks, block)
+	}
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i].height < blocks[j].height })
+
+	// roots of kept blocks at every height
+	kept := map[uint64][]types.Hash{final.height: {final.root}}
+	for _, block := range blocks {
+		if block.height > final.height && containsHash(kept[block.height-1], block.prevRoot) {
+			kept[block.height] = append(kept[block.height], block.root)
+			continue
+		}
+		delete(e.pendingBlocks, pendingKey{height: block.height, prevRoot: string(block.prevRoot)})
+	}
+}
+
+func containsHash(hashes []types.Hash, hash types.Hash) bool {
+	for _, h := range hashes {
+		if bytes.Equal(h, hash) {
+			return true
+		}
+	}
+	return false
+}
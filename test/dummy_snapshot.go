@@ -0,0 +1,160 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/rollkit/go-execution/types"
+)
+
+const (
+	// dummySnapshotFormat is the only snapshot format supported by DummyExecutor.
+	dummySnapshotFormat = 1
+	// dummySnapshotChunkSize is deliberately small, so snapshots consist of multiple chunks.
+	dummySnapshotChunkSize = 32
+	// dummySnapshotKeepRecent is the number of most recent snapshots kept by DummyExecutor.
+	dummySnapshotKeepRecent = 2
+)
+
+type dummySnapshot struct {
+	types.Snapshot
+	data []byte
+}
+
+type dummyRestore struct {
+	snapshot types.Snapshot
+	data     []byte
+	next     uint32
+}
+
+// takeSnapshot snapshots the finalized state. Caller must hold the lock.
+func (e *DummyExecutor) takeSnapshot() {
+	data := binary.BigEndian.AppendUint64(nil, e.finalHeight)
+	data = binary.BigEndian.AppendUint64(data, e.maxBytes)
+	data = append(data, e.stateRoot...)
+	hash := sha256.Sum256(data)
+
+	snapshot := dummySnapshot{
+		Snapshot: types.Snapshot{
+			Height:    e.finalHeight,
+			Format:    dummySnapshotFormat,
+			Chunks:    uint32((len(data) + dummySnapshotChunkSize - 1) / dummySnapshotChunkSize), //nolint:gosec
+			Hash:      hash[:],
+			StateRoot: e.stateRoot,
+		},
+		data: data,
+	}
+	e.snapshots = append([]dummySnapshot{snapshot}, e.snapshots...)
+	if len(e.snapshots) > dummySnapshotKeepRecent {
+		e.snapshots = e.snapshots[:dummySnapshotKeepRecent]
+	}
+}
+
+// ListSnapshots returns snapshots of the most recently finalized blocks.
+func (e *DummyExecutor) ListSnapshots(ctx context.Context) ([]types.Snapshot, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	snapshots := make([]types.Snapshot, len(e.snapshots))
+	for i, snapshot := range e.snapshots {
+		snapshots[i] = snapshot.Snapshot
+	}
+	return snapshots, nil
+}
+
+// LoadSnapshotChunk returns a chunk of the snapshot taken at given height.
+func (e *DummyExecutor) LoadSnapshotChunk(ctx context.Context, height uint64, format uint32, index uint32) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	for _, snapshot := range e.snapshots {
+		if snapshot.Height != height || snapshot.Format != format {
+			continue
+		}
+		if index >= snapshot.Chunks {
+			break
+		}
+		start := int(index) * dummySnapshotChunkSize
+		end := min(start+dummySnapshotChunkSize, len(snapshot.data))
+		return snapshot.data[start:end], nil
+	}
+	return nil, types.ErrSnapshotNotFound
+}
+
+// OfferSnapshot starts restoration of the snapshot. Only snapshots of uninitialized DummyExecutor are accepted.
+func (e *DummyExecutor) OfferSnapshot(ctx context.Context, snapshot types.Snapshot) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.restore = nil
+	switch {
+	case e.initialized:
+		return fmt.Errorf("%w: chain already initialized", types.ErrSnapshotRejected)
+	case snapshot.Format != dummySnapshotFormat:
+		return fmt.Errorf("%w: unsupported format %d", types.ErrSnapshotRejected, snapshot.Format)
+	case snapshot.Chunks == 0:
+		return fmt.Errorf("%w: no chunks", types.ErrSnapshotRejected)
+	}
+	e.restore = &dummyRestore{snapshot: snapshot}
+	return nil
+}
+
+// ApplySnapshotChunk applies a chunk of the offered snapshot. After the last chunk, the snapshot is verified
+// and DummyExecutor is initialized with its state.
+func (e *DummyExecutor) ApplySnapshotChunk(ctx context.Context, index uint32, chunk []byte) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.restore == nil {
+		return false, fmt.Errorf("%w: no snapshot offered", types.ErrInvalidSnapshotChunk)
+	}
+	if index != e.restore.next {
+		return false, fmt.Errorf("%w: expected chunk %d, got %d", types.ErrInvalidSnapshotChunk, e.restore.next, index)
+	}
+	e.restore.data = append(e.restore.data, chunk...)
+	e.restore.next++
+	if e.restore.next < e.restore.snapshot.Chunks {
+		return false, nil
+	}
+
+	restore := e.restore
+	e.restore = nil
+	hash := sha256.Sum256(restore.data)
+	if !bytes.Equal(hash[:], restore.snapshot.Hash) || len(restore.data) < 16 {
+		return false, fmt.Errorf("%w: snapshot hash mismatch", types.ErrInvalidSnapshotChunk)
+	}
+	height := binary.BigEndian.Uint64(restore.data)
+	stateRoot := restore.data[16:]
+	if height != restore.snapshot.Height || !bytes.Equal(stateRoot, restore.snapshot.StateRoot) {
+		return false, fmt.Errorf("%w: snapshot contents don't match its description", types.ErrInvalidSnapshotChunk)
+	}
+
+	// history before the snapshot is not available
+	e.finalHeight = height
+	e.finalRoots = map[uint64]types.Hash{height: stateRoot}
+	e.retainHeight = height
+	e.maxBytes = binary.BigEndian.Uint64(restore.data[8:])
+	e.stateRoot = stateRoot
+	e.initialized = true
+	e.takeSnapshot()
+	return true, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/rollkit/go-execution/mempool"
 	"github.com/rollkit/go-execution/types"
 )
 
@@ -64,6 +65,38 @@ func (s *DummyTestSuite) TestTxRemoval() {
 	require.Contains(t, txs, tx2)
 }
 
+func (s *DummyTestSuite) TestInjectTxDuplicates() {
+	t := s.T()
+	ctx := context.Background()
+	tx1 := types.Tx("tx1")
+	tx2 := types.Tx("tx2")
+
+	// injected transactions are kept in order, including duplicates
+	exec := NewDummyExecutor()
+	for _, tx := range []types.Tx{tx1, tx2, tx1} {
+		exec.InjectTx(tx)
+	}
+	txs, err := exec.GetTxs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.Tx{tx1, tx2, tx1}, txs)
+
+	// all copies of executed transaction are removed
+	_, _, err = exec.ExecuteTxs(ctx, []types.Tx{tx1}, 1, time.Now(), types.Hash{1})
+	require.NoError(t, err)
+	txs, err = exec.GetTxs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.Tx{tx2}, txs)
+
+	// mempool ignores duplicates
+	exec = NewDummyExecutorWithMempool(mempool.New(nil))
+	for _, tx := range []types.Tx{tx1, tx2, tx1} {
+		exec.InjectTx(tx)
+	}
+	txs, err = exec.GetTxs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.Tx{tx1, tx2}, txs)
+}
+
 func (s *DummyTestSuite) TestExecuteTxsComprehensive() {
 	t := s.T()
 	tests := []struct {
@@ -228,3 +261,287 @@ func (s *DummyTestSuite) TestGetTxsWithConcurrency() {
 	}
 	require.Len(t, txMap, numGoroutines*txsPerGoroutine)
 }
+
+func (s *DummyTestSuite) TestSimulateTxs() {
+	t := s.T()
+	exec := NewDummyExecutor()
+	ctx := context.Background()
+	txs := []types.Tx{types.Tx("tx1"), types.Tx("tx2")}
+	prevStateRoot := types.Hash("prev")
+	exec.InjectTx(txs[0])
+
+	result, err := exec.SimulateTxs(ctx, txs, 1, time.Now(), prevStateRoot)
+	require.NoError(t, err)
+	require.Empty(t, result.RejectedTxs)
+
+	// simulation doesn't create pending block nor touches the mempool
+	require.ErrorIs(t, exec.SetFinal(ctx, 1), types.ErrBlockNotFound)
+	pending, err := exec.GetTxs(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	stateRoot, maxBytes, err := exec.ExecuteTxs(ctx, txs, 1, time.Now(), prevStateRoot)
+	require.NoError(t, err)
+	require.Equal(t, stateRoot, result.StateRoot)
+	require.Equal(t, maxBytes, result.MaxBytes)
+
+	_, err = exec.SimulateTxs(ctx, txs, 2, time.Now(), types.Hash{})
+	require.ErrorIs(t, err, types.ErrEmptyStateRoot)
+}
+
+func (s *DummyTestSuite) TestExecuteBlocks() {
+	t := s.T()
+	exec := NewDummyExecutor()
+	ctx := context.Background()
+	prevStateRoot := types.Hash("prev")
+	exec.InjectTx(types.Tx("tx1"))
+
+	blocks := []types.BatchBlock{
+		{Txs: []types.Tx{types.Tx("tx1")}, Height: 1, Timestamp: time.Now()},
+		{Txs: []types.Tx{types.Tx("tx2")}, Height: 2, Timestamp: time.Now()},
+		{Txs: []types.Tx{nil}, Height: 3, Timestamp: time.Now()},
+	}
+	stateRoots, _, err := exec.ExecuteBlocks(ctx, blocks, prevStateRoot)
+	require.ErrorIs(t, err, types.ErrEmptyTx)
+	var batchErr *types.BatchError
+	require.ErrorAs(t, err, &batchErr)
+	require.Equal(t, 2, batchErr.Index)
+	require.Len(t, stateRoots, 2)
+
+	// blocks are chained like consecutive ExecuteTxs calls
+	expected, _, err := NewDummyExecutor().ExecuteTxs(ctx, blocks[0].Txs, 1, time.Now(), prevStateRoot)
+	require.NoError(t, err)
+	require.Equal(t, expected, stateRoots[0])
+	expected, _, err = NewDummyExecutor().ExecuteTxs(ctx, blocks[1].Txs, 2, time.Now(), expected)
+	require.NoError(t, err)
+	require.Equal(t, expected, stateRoots[1])
+
+	// executed blocks are pending and their transactions left the mempool
+	require.NoError(t, exec.SetFinal(ctx, 2))
+	require.ErrorIs(t, exec.SetFinal(ctx, 3), types.ErrBlockNotFound)
+	pending, err := exec.GetTxs(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
+
+func (s *DummyTestSuite) TestSnapshotRestore() {
+	t := s.T()
+	ctx := context.Background()
+	source := NewDummyExecutor()
+	stateRoot, _, err := source.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+	for height := uint64(1); height <= 3; height++ {
+		stateRoot, _, err = source.ExecuteTxs(ctx, []types.Tx{types.Tx(fmt.Sprintf("tx-%d", height))}, height, time.Now(), stateRoot)
+		require.NoError(t, err)
+		require.NoError(t, source.SetFinal(ctx, height))
+	}
+
+	snapshots, err := source.ListSnapshots(ctx)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2, "only recent snapshots are kept")
+	snapshot := snapshots[0]
+	require.Equal(t, uint64(3), snapshot.Height)
+	require.Equal(t, stateRoot, snapshot.StateRoot)
+	require.Greater(t, snapshot.Chunks, uint32(1))
+
+	target := NewDummyExecutor()
+	require.NoError(t, target.OfferSnapshot(ctx, snapshot))
+	_, err = target.ApplySnapshotChunk(ctx, 1, nil)
+	require.ErrorIs(t, err, types.ErrInvalidSnapshotChunk)
+
+	require.NoError(t, target.OfferSnapshot(ctx, snapshot))
+	for index := uint32(0); index < snapshot.Chunks; index++ {
+		chunk, err := source.LoadSnapshotChunk(ctx, snapshot.Height, snapshot.Format, index)
+		require.NoError(t, err)
+		done, err := target.ApplySnapshotChunk(ctx, index, chunk)
+		require.NoError(t, err)
+		require.Equal(t, index == snapshot.Chunks-1, done)
+	}
+	require.Equal(t, source.GetStateRoot(), target.GetStateRoot())
+	require.NoError(t, target.CheckHealth(ctx))
+
+	// restored executor continues the chain like the source
+	expected, _, err := source.ExecuteTxs(ctx, nil, 4, time.Now(), stateRoot)
+	require.NoError(t, err)
+	actual, _, err := target.ExecuteTxs(ctx, nil, 4, time.Now(), stateRoot)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	require.ErrorIs(t, target.OfferSnapshot(ctx, snapshot), types.ErrSnapshotRejected)
+	_, err = source.LoadSnapshotChunk(ctx, snapshot.Height, snapshot.Format, snapshot.Chunks)
+	require.ErrorIs(t, err, types.ErrSnapshotNotFound)
+}
+
+func (s *DummyTestSuite) TestPrune() {
+	t := s.T()
+	ctx := context.Background()
+	exec := NewDummyExecutor()
+	stateRoot, _, err := exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+	roots := []types.Hash{stateRoot}
+	for height := uint64(1); height <= 5; height++ {
+		stateRoot, _, err = exec.ExecuteTxs(ctx, nil, height, time.Now(), stateRoot)
+		require.NoError(t, err)
+		require.NoError(t, exec.SetFinal(ctx, height))
+		roots = append(roots, stateRoot)
+	}
+	// block competing with finalized block at height 2
+	_, _, err = exec.ExecuteTxs(ctx, []types.Tx{types.Tx("tx")}, 2, time.Now(), roots[1])
+	require.NoError(t, err)
+
+	require.ErrorIs(t, exec.Prune(ctx, 6, 0), types.ErrInvalidBlockHeight)
+	require.NoError(t, exec.Prune(ctx, 4, 0))
+	require.Len(t, exec.finalRoots, 2)
+	require.Contains(t, exec.finalRoots, uint64(4))
+	require.Empty(t, exec.pendingBlocks, "pending blocks below retain height are pruned")
+
+	// lower retain height is a no-op
+	require.NoError(t, exec.Prune(ctx, 2, 0))
+	require.Len(t, exec.finalRoots, 2)
+	require.Equal(t, stateRoot, exec.GetStateRoot())
+}
+
+func (s *DummyTestSuite) TestStateRootAt() {
+	t := s.T()
+	ctx := context.Background()
+	exec := NewDummyExecutor()
+	_, err := exec.ChainStatus(ctx)
+	require.ErrorIs(t, err, types.ErrChainNotInitialized)
+
+	genesisRoot, _, err := exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+	root1, _, err := exec.ExecuteTxs(ctx, nil, 1, time.Now(), genesisRoot)
+	require.NoError(t, err)
+	require.NoError(t, exec.SetFinal(ctx, 1))
+	root2, _, err := exec.ExecuteTxs(ctx, nil, 2, time.Now(), root1)
+	require.NoError(t, err)
+
+	for height, expected := range map[uint64]struct {
+		root      types.Hash
+		finalized bool
+	}{
+		0: {genesisRoot, true},
+		1: {root1, true},
+		2: {root2, false},
+	} {
+		root, finalized, err := exec.StateRootAt(ctx, height)
+		require.NoError(t, err)
+		require.Equal(t, expected.root, root, "height %d", height)
+		require.Equal(t, expected.finalized, finalized, "height %d", height)
+	}
+	_, _, err = exec.StateRootAt(ctx, 3)
+	require.ErrorIs(t, err, types.ErrBlockNotFound)
+
+	status, err := exec.ChainStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &types.ChainStatus{LatestHeight: 2, FinalizedHeight: 1, FinalizedStateRoot: root1}, status)
+
+	require.NoError(t, exec.Prune(ctx, 1, 0))
+	_, _, err = exec.StateRootAt(ctx, 0)
+	require.ErrorIs(t, err, types.ErrBlockNotFound)
+}
+
+func (s *DummyTestSuite) TestForks() {
+	t := s.T()
+	ctx := context.Background()
+	exec := NewDummyExecutor()
+	genesisRoot, _, err := exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+
+	// blocks on unknown state roots are rejected
+	_, _, err = exec.ExecuteTxs(ctx, []types.Tx{types.Tx("x1")}, 1, time.Now(), types.Hash("other"))
+	require.ErrorIs(t, err, types.ErrBlockNotFound)
+
+	// a2 on top of a1, then a1 is replaced by b1 executed on the same state root, and b2 competes with a2
+	a1, _, err := exec.ExecuteTxs(ctx, []types.Tx{types.Tx("a1")}, 1, time.Now(), genesisRoot)
+	require.NoError(t, err)
+	a2, _, err := exec.ExecuteTxs(ctx, []types.Tx{types.Tx("a2")}, 2, time.Now(), a1)
+	require.NoError(t, err)
+	b1, _, err := exec.ExecuteTxs(ctx, []types.Tx{types.Tx("b1")}, 1, time.Now(), genesisRoot)
+	require.NoError(t, err)
+	b2, _, err := exec.ExecuteTxs(ctx, []types.Tx{types.Tx("b2")}, 2, time.Now(), b1)
+	require.NoError(t, err)
+	require.Len(t, exec.pendingBlocks, 3, "competing blocks must not overwrite each other")
+	_, _, err = exec.ExecuteTxs(ctx, []types.Tx{types.Tx("a3")}, 3, time.Now(), a2)
+	require.NoError(t, err)
+
+	// only blocks chained from the finalized state are candidates
+	root, finalized, err := exec.StateRootAt(ctx, 2)
+	require.NoError(t, err)
+	require.False(t, finalized)
+	require.Equal(t, b2, root)
+	require.ErrorIs(t, exec.SetFinal(ctx, 3), types.ErrBlockNotFound)
+	require.NoError(t, exec.SetFinal(ctx, 1))
+	require.Equal(t, b1, exec.GetStateRoot())
+	require.Len(t, exec.pendingBlocks, 1, "losing branch is discarded")
+
+	require.NoError(t, exec.SetFinal(ctx, 2))
+	require.Equal(t, b2, exec.GetStateRoot())
+	require.Empty(t, exec.pendingBlocks)
+}
+
+func (s *DummyTestSuite) TestSetFinalIntermediateHeights() {
+	t := s.T()
+	ctx := context.Background()
+	exec := NewDummyExecutor()
+	stateRoot, _, err := exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+	roots := []types.Hash{stateRoot}
+	for height := uint64(1); height <= 5; height++ {
+		stateRoot, _, err = exec.ExecuteTxs(ctx, nil, height, time.Now(), stateRoot)
+		require.NoError(t, err)
+		roots = append(roots, stateRoot)
+	}
+	require.NoError(t, exec.SetFinal(ctx, 2))
+
+	// every height between the finalized block and the block is finalized
+	require.NoError(t, exec.SetFinal(ctx, 5))
+	for height := uint64(1); height <= 5; height++ {
+		root, finalized, err := exec.StateRootAt(ctx, height)
+		require.NoError(t, err, "height %d", height)
+		require.True(t, finalized, "height %d", height)
+		require.Equal(t, roots[height], root, "height %d", height)
+	}
+
+	// block whose parent was replaced can't be finalized, as the chain has a gap
+	_, _, err = exec.ExecuteTxs(ctx, nil, 6, time.Now(), roots[5])
+	require.NoError(t, err)
+	_, _, err = exec.ExecuteTxs(ctx, nil, 7, time.Now(), exec.pendingAt(6).root)
+	require.NoError(t, err)
+	_, _, err = exec.ExecuteTxs(ctx, []types.Tx{types.Tx("tx")}, 6, time.Now(), roots[5])
+	require.NoError(t, err)
+	require.ErrorIs(t, exec.SetFinal(ctx, 7), types.ErrBlockNotFound)
+	require.NoError(t, exec.SetFinal(ctx, 6))
+}
+
+func (s *DummyTestSuite) TestSetFinalCascadeForks() {
+	t := s.T()
+	ctx := context.Background()
+	exec := NewDummyExecutor()
+	genesisRoot, _, err := exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+
+	a1, _, err := exec.ExecuteTxs(ctx, []types.Tx{types.Tx("a1")}, 1, time.Now(), genesisRoot)
+	require.NoError(t, err)
+	_, _, err = exec.ExecuteTxs(ctx, []types.Tx{types.Tx("a2")}, 2, time.Now(), a1)
+	require.NoError(t, err)
+	b1, _, err := exec.ExecuteTxs(ctx, []types.Tx{types.Tx("b1")}, 1, time.Now(), genesisRoot)
+	require.NoError(t, err)
+	b2, _, err := exec.ExecuteTxs(ctx, []types.Tx{types.Tx("b2")}, 2, time.Now(), b1)
+	require.NoError(t, err)
+
+	// finalizing height 2 finalizes the branch chained from the finalized state
+	require.NoError(t, exec.SetFinal(ctx, 2))
+	require.Equal(t, b2, exec.GetStateRoot())
+	require.Empty(t, exec.pendingBlocks)
+	root, finalized, err := exec.StateRootAt(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, finalized)
+	require.Equal(t, b1, root)
+
+	// finalized height never decreases
+	require.NoError(t, exec.SetFinal(ctx, 1))
+	status, err := exec.ChainStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), status.FinalizedHeight)
+}
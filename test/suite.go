@@ -57,6 +57,30 @@ func (s *ExecutorSuite) TestGetTxs() {
 	s.Require().Contains(txs, tx2)
 }
 
+// TestGetTxsWithLimits tests that transactions returned with limits fit into them.
+func (s *ExecutorSuite) TestGetTxsWithLimits() {
+	s.skipIfInjectorNotSet()
+
+	for _, tx := range []string{"tx1", "tx2", "tx3"} {
+		s.TxInjector.InjectTx(types.Tx(tx))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	txs, err := execution.GetTxsWithLimits(ctx, s.Exec, types.TxLimits{MaxTxs: 2})
+	s.Require().NoError(err)
+	s.Len(txs, 2)
+
+	txs, err = execution.GetTxsWithLimits(ctx, s.Exec, types.TxLimits{MaxBytes: 7})
+	s.Require().NoError(err)
+	s.Len(txs, 2)
+
+	txs, err = execution.GetTxsWithLimits(ctx, s.Exec, types.TxLimits{MaxBytes: 2})
+	s.Require().NoError(err)
+	s.Empty(txs)
+}
+
 func (s *ExecutorSuite) skipIfInjectorNotSet() {
 	if s.TxInjector == nil {
 		s.T().Skipf("Skipping %s because TxInjector is not provided", s.T().Name())
@@ -99,6 +123,56 @@ func (s *ExecutorSuite) TestSetFinal() {
 	s.Require().NoError(err)
 }
 
+// executeBlocks initializes the chain and executes n chained blocks without finalizing them.
+func (s *ExecutorSuite) executeBlocks(ctx context.Context, n uint64) []types.Hash {
+	genesisTime := time.Now().UTC()
+	stateRoot, _, err := s.Exec.InitChain(ctx, genesisTime, 1, "test-chain")
+	s.Require().NoError(err)
+
+	roots := []types.Hash{stateRoot}
+	for height := uint64(1); height <= n; height++ {
+		stateRoot, _, err = s.Exec.ExecuteTxs(ctx, nil, height, genesisTime.Add(time.Duration(height)*time.Second), stateRoot) //nolint:gosec
+		s.Require().NoError(err)
+		roots = append(roots, stateRoot)
+	}
+	return roots
+}
+
+// TestSetFinalCascade tests that finalizing a block finalizes pending blocks below it, and that finalizing
+// already finalized heights, repeatedly or out of order, succeeds.
+func (s *ExecutorSuite) TestSetFinalCascade() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	roots := s.executeBlocks(ctx, 3)
+	s.Require().NoError(s.Exec.SetFinal(ctx, 3))
+
+	// lower heights were finalized together with height 3
+	s.Require().NoError(s.Exec.SetFinal(ctx, 1))
+	s.Require().NoError(s.Exec.SetFinal(ctx, 2))
+	s.Require().NoError(s.Exec.SetFinal(ctx, 3))
+	s.Require().NoError(s.Exec.SetFinal(ctx, 3))
+
+	// chain continues on top of the finalized block
+	_, _, err := s.Exec.ExecuteTxs(ctx, nil, 4, time.Now(), roots[3])
+	s.Require().NoError(err)
+	s.Require().NoError(s.Exec.SetFinal(ctx, 4))
+	s.Require().Error(s.Exec.SetFinal(ctx, 5))
+}
+
+// TestSetFinalRange tests finalization of a range of blocks.
+func (s *ExecutorSuite) TestSetFinalRange() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s.executeBlocks(ctx, 4)
+	s.Require().ErrorIs(execution.SetFinalRange(ctx, s.Exec, 3, 2), types.ErrInvalidBlockHeight)
+	s.Require().NoError(execution.SetFinalRange(ctx, s.Exec, 1, 2))
+	// overlapping range
+	s.Require().NoError(execution.SetFinalRange(ctx, s.Exec, 2, 4))
+	s.Require().Error(execution.SetFinalRange(ctx, s.Exec, 4, 5))
+}
+
 // TestMultipleBlocks is a basic test ensuring that all API methods used together can be used to produce multiple blocks.
 func (s *ExecutorSuite) TestMultipleBlocks() {
 	genesisTime := time.Now().UTC()
@@ -1,6 +1,9 @@
 package types
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// Chain initialization errors
@@ -15,6 +18,8 @@ var (
 	ErrChainIDTooLong = errors.New("chain ID exceeds maximum length")
 	// ErrFutureGenesisTime is returned when the genesis time is in the future
 	ErrFutureGenesisTime = errors.New("genesis time cannot be in the future")
+	// ErrChainNotInitialized is returned when the chain was not initialized yet
+	ErrChainNotInitialized = errors.New("chain not initialized")
 
 	// Transaction execution errors
 
@@ -28,6 +33,8 @@ var (
 	ErrTxTooLarge = errors.New("transaction size exceeds maximum allowed")
 	// ErrEmptyTx is returned when the transaction is empty
 	ErrEmptyTx = errors.New("transaction cannot be empty")
+	// ErrStateRootMismatch is returned when the state root of executed block differs from the expected one
+	ErrStateRootMismatch = errors.New("state root mismatch")
 
 	// Block finalization errors
 
@@ -46,6 +53,8 @@ var (
 	ErrTxPoolFull = errors.New("transaction pool is full")
 	// ErrInvalidTxFormat is returned when the transaction format is invalid
 	ErrInvalidTxFormat = errors.New("invalid transaction format")
+	// ErrInvalidPageToken is returned when the page token of paginated GetTxs is malformed
+	ErrInvalidPageToken = errors.New("invalid page token")
 
 	// Context errors
 
@@ -53,4 +62,48 @@ var (
 	ErrContextCanceled = errors.New("context canceled")
 	// ErrContextTimeout is returned when the context deadline is exceeded
 	ErrContextTimeout = errors.New("context deadline exceeded")
+
+	// State sync errors
+
+	// ErrSnapshotNotFound is returned when the snapshot or its chunk is not found
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+	// ErrSnapshotRejected is returned when the offered snapshot can't be restored
+	ErrSnapshotRejected = errors.New("snapshot rejected")
+	// ErrInvalidSnapshotChunk is returned when the snapshot chunk is unexpected or fails verification
+	ErrInvalidSnapshotChunk = errors.New("invalid snapshot chunk")
 )
+
+// StateRootMismatchError is returned when the state root of executed block differs from the expected one.
+// It matches ErrStateRootMismatch with errors.Is.
+type StateRootMismatchError struct {
+	Height   uint64
+	Expected Hash
+	Actual   Hash
+}
+
+// Error implements error.
+func (e *StateRootMismatchError) Error() string {
+	return fmt.Sprintf("%s at height %d: expected %X, got %X", ErrStateRootMismatch, e.Height, e.Expected, e.Actual)
+}
+
+// Is reports whether target is ErrStateRootMismatch.
+func (e *StateRootMismatchError) Is(target error) bool {
+	return target == ErrStateRootMismatch
+}
+
+// BatchError is returned when execution of a block in a batch fails. Blocks before Index were executed.
+type BatchError struct {
+	// Index is the index of the failed block in the batch.
+	Index int
+	Err   error
+}
+
+// Error implements error.
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("block %d of batch: %s", e.Index, e.Err)
+}
+
+// Unwrap returns the error of the failed block.
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
@@ -1,7 +1,140 @@
 package types
 
+import (
+	"crypto/sha256"
+	"time"
+)
+
 // Tx represents a transaction in the form of a byte slice.
 type Tx []byte
 
 // Hash is a type alias for header.Hash
 type Hash = []byte
+
+// TxInfo is a transaction with metadata that can be used to order transactions in a block.
+type TxInfo struct {
+	Tx Tx
+	// Hash is the hash of the transaction, as defined by the execution layer.
+	Hash Hash
+	// Priority is the priority of the transaction, e.g. its fee; higher is better.
+	Priority int64
+	// Sender is the address of the transaction sender, if known.
+	Sender []byte
+	// Nonce is the nonce of the transaction within transactions of the sender, if known.
+	Nonce uint64
+	// Size is the size of the transaction in bytes.
+	Size uint64
+}
+
+// NewTxInfo returns TxInfo of transaction with unknown metadata, using SHA-256 as the hash.
+func NewTxInfo(tx Tx) TxInfo {
+	hash := sha256.Sum256(tx)
+	return TxInfo{
+		Tx:   tx,
+		Hash: hash[:],
+		Size: uint64(len(tx)),
+	}
+}
+
+// TxLimits restricts transactions returned by GetTxs. Zero value of any field means no limit.
+type TxLimits struct {
+	// MaxBytes limits total size of transactions.
+	MaxBytes uint64
+	// MaxTxs limits number of transactions.
+	MaxTxs uint64
+	// MaxGas limits total gas of transactions. It's ignored by executors without the notion of gas.
+	MaxGas uint64
+}
+
+// IsZero reports whether no limit is set.
+func (l TxLimits) IsZero() bool {
+	return l == TxLimits{}
+}
+
+// Trim returns the longest prefix of txs within MaxBytes and MaxTxs. MaxGas is not applied.
+func (l TxLimits) Trim(txs []Tx) []Tx {
+	var size uint64
+	for i, tx := range txs {
+		if l.MaxTxs > 0 && uint64(i) >= l.MaxTxs {
+			return txs[:i]
+		}
+		size += uint64(len(tx))
+		if l.MaxBytes > 0 && size > l.MaxBytes {
+			return txs[:i]
+		}
+	}
+	return txs
+}
+
+// SimulationResult is the outcome of simulated execution of a block.
+type SimulationResult struct {
+	// StateRoot is the state root the block would produce.
+	StateRoot Hash
+	// MaxBytes is the maximum allowed bytes for transactions in a block, as returned by ExecuteTxs.
+	MaxBytes uint64
+	// RejectedTxs are transactions that would be rejected by the execution layer.
+	RejectedTxs []RejectedTx
+	// GasUsed is the total gas used by the block, or 0 if the execution layer has no notion of gas.
+	GasUsed uint64
+}
+
+// RejectedTx describes a transaction rejected during execution.
+type RejectedTx struct {
+	// Index is the index of the transaction in the block.
+	Index int
+	// Reason is a human readable reason of the rejection.
+	Reason string
+}
+
+// BatchBlock is a block executed as part of a batch of blocks.
+type BatchBlock struct {
+	Txs       []Tx
+	Height    uint64
+	Timestamp time.Time
+}
+
+// Snapshot describes a snapshot of the execution layer state at given height, split into chunks.
+type Snapshot struct {
+	// Height is the height of the last block included in the snapshot.
+	Height uint64
+	// Format is the application-specific format of the snapshot, so incompatible snapshots can be rejected.
+	Format uint32
+	// Chunks is the number of chunks of the snapshot.
+	Chunks uint32
+	// Hash is the hash of the snapshot contents, used to verify restored chunks.
+	Hash Hash
+	// StateRoot is the state root after the block at Height.
+	StateRoot Hash
+	// Metadata is arbitrary application-specific metadata.
+	Metadata []byte
+}
+
+// ChainStatus describes the progress of the chain in the execution layer.
+type ChainStatus struct {
+	// LatestHeight is the height of the latest executed block, finalized or not.
+	LatestHeight uint64
+	// FinalizedHeight is the height of the latest finalized block, or initial height - 1 if no block was finalized.
+	FinalizedHeight uint64
+	// FinalizedStateRoot is the state root after the latest finalized block.
+	FinalizedStateRoot Hash
+}
+
+// ExecutorInfo describes an executor served by a proxy.
+type ExecutorInfo struct {
+	Name    string
+	Version string
+	// ProtocolVersion is the version of the proxy protocol.
+	ProtocolVersion uint32
+	// Capabilities are names of optional interfaces implemented by the executor, see execution.Capabilities.
+	Capabilities []string
+}
+
+// HasCapability reports whether capability is one of Capabilities.
+func (i *ExecutorInfo) HasCapability(capability string) bool {
+	for _, c := range i.Capabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package wasm
+
+import "time"
+
+// Config holds configuration settings for the WASM executor.
+type Config struct {
+	// MaxBytes is the maximum allowed bytes for transactions in a block, reported by InitChain and ExecuteTxs.
+	MaxBytes uint64
+	// FuelLimit is the maximum amount of fuel used by a single transaction, or a single InitChain or Query call.
+	FuelLimit uint64
+	// CallCost is the fuel charged for every call of a function defined by the module.
+	CallCost uint64
+	// LoopCost is the fuel charged for every iteration of a loop of the module.
+	LoopCost uint64
+	// ReadCost and WriteCost are the fuel charged for every read from and write to the store.
+	ReadCost  uint64
+	WriteCost uint64
+	// ByteCost is the fuel charged for every byte of keys and values read from or written to the store.
+	ByteCost uint64
+	// MaxMemoryPages limits memory of the module, in 64KiB pages.
+	MaxMemoryPages uint32
+	// ExecutionTimeout limits wall-clock time of a single call. It only protects the host, as fuel metering
+	// already bounds the execution: call exceeding the timeout fails without any effect on the state.
+	// Zero disables the timeout.
+	ExecutionTimeout time.Duration
+}
+
+// DefaultConfig returns a Config instance populated with default settings.
+func DefaultConfig() *Config {
+	return &Config{
+		MaxBytes:         1024 * 1024,
+		FuelLimit:        10_000_000,
+		CallCost:         1,
+		LoopCost:         1,
+		ReadCost:         10,
+		WriteCost:        20,
+		ByteCost:         1,
+		MaxMemoryPages:   256,
+		ExecutionTimeout: 5 * time.Second,
+	}
+}
@@ -0,0 +1,16 @@
+package wasm
+
+import "errors"
+
+var (
+	// ErrInvalidModule is returned when the module doesn't export required functions with expected signatures
+	ErrInvalidModule = errors.New("invalid WASM module")
+	// ErrOutOfFuel is returned when execution exceeds the fuel limit
+	ErrOutOfFuel = errors.New("out of fuel")
+	// ErrReadOnly is returned when the module writes to the store during query
+	ErrReadOnly = errors.New("store is read-only")
+	// ErrMemoryAccess is returned when the module passes pointer outside of its memory
+	ErrMemoryAccess = errors.New("memory access out of bounds")
+	// ErrInitChainFailed is returned when init function of the module returns non-zero code
+	ErrInitChainFailed = errors.New("module init failed")
+)
@@ -0,0 +1,460 @@
+// Package wasm implements execution.Executor running state transitions defined by a WebAssembly module.
+//
+// The module is executed in a sandbox by a pure Go runtime. It has no access to the host except
+// the key/value store provided as "env" module imports (see instantiateHost). The module must export
+// its memory as "memory" and the following functions:
+//
+//	alloc(size i32) i32
+//	init(chain_id_ptr, chain_id_len i32, initial_height i64) i32
+//	execute(height, timestamp i64, tx_ptr, tx_len i32) i32
+//	query(req_ptr, req_len i32) i64
+//
+// alloc returns pointer to size bytes of memory, used by the host to pass inputs to other functions.
+// init sets up the genesis state and returns 0 on success. execute is called for every transaction of
+// a block and returns result code; writes of transactions with non-zero code are reverted. query returns
+// pointer (upper 32 bits) and length (lower 32 bits) of the response.
+package wasm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/sys"
+
+	"github.com/rollkit/go-execution"
+	"github.com/rollkit/go-execution/mempool"
+	"github.com/rollkit/go-execution/types"
+)
+
+var i32, i64 = api.ValueTypeI32, api.ValueTypeI64
+
+// exports describes functions required from the module.
+var exports = map[string]struct{ params, results []api.ValueType }{
+	"alloc":   {[]api.ValueType{i32}, []api.ValueType{i32}},
+	"init":    {[]api.ValueType{i32, i32, i64}, []api.ValueType{i32}},
+	"execute": {[]api.ValueType{i64, i64, i32, i32}, []api.ValueType{i32}},
+	"query":   {[]api.ValueType{i32, i32}, []api.ValueType{i64}},
+}
+
+// block is executed, but not yet finalized block.
+type block struct {
+	root  types.Hash
+	state *overlay
+	// seq orders blocks by execution, so the most recently executed candidate can be preferred.
+	seq uint64
+}
+
+// pendingKey identifies a pending block by its height and the state root it was executed on, so competing
+// blocks at the same height can coexist until one of them is finalized.
+type pendingKey struct {
+	height   uint64
+	prevRoot string
+}
+
+// Executor implements execution.Executor by running a WebAssembly module.
+//
+// Every call is executed by a fresh instance of the module, so the module can't keep any state outside
+// of the store. State root is computed by the host from the store contents, so it doesn't depend on
+// the module. Fuel used by a single transaction, InitChain or Query call is limited by Config.FuelLimit.
+// Fuel is charged by code injected into the module at every function entry and loop iteration (see meter),
+// and by host functions for every store access, which makes the fuel usage deterministic.
+type Executor struct {
+	config   *Config
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+
+	mu          sync.Mutex
+	initialized bool
+	finalized   map[string][]byte
+	finalRoot   types.Hash
+	// finalHeight is the height of the last finalized block, or initial height - 1.
+	finalHeight uint64
+	pending     map[pendingKey]*block
+	pendingSeq  uint64
+	mempool     *mempool.Mempool
+}
+
+var (
+	_ execution.Executor      = (*Executor)(nil)
+	_ execution.HealthChecker = (*Executor)(nil)
+)
+
+// NewExecutor compiles the WebAssembly module and creates a new Executor running it.
+// Executor must be closed with Close to release resources of the runtime.
+func NewExecutor(ctx context.Context, module []byte, config *Config) (*Executor, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	runtimeConfig := wazero.NewRuntimeConfig().WithCloseOnContextDone(true)
+	if config.MaxMemoryPages > 0 {
+		runtimeConfig = runtimeConfig.WithMemoryLimitPages(config.MaxMemoryPages)
+	}
+	r := wazero.NewRuntimeWithConfig(ctx, runtimeConfig)
+	if err := instantiateHost(ctx, r); err != nil {
+		_ = r.Close(ctx)
+		return nil, err
+	}
+
+	compiled, err := compile(ctx, r, module, config)
+	if err != nil {
+		_ = r.Close(ctx)
+		return nil, err
+	}
+	if err := validateExports(compiled); err != nil {
+		_ = r.Close(ctx)
+		return nil, err
+	}
+
+	return &Executor{
+		config:    config,
+		runtime:   r,
+		compiled:  compiled,
+		finalized: make(map[string][]byte),
+		pending:   make(map[pendingKey]*block),
+		mempool:   mempool.New(nil),
+	}, nil
+}
+
+// compile validates the module, then compiles it instrumented with fuel metering.
+func compile(ctx context.Context, r wazero.Runtime, module []byte, config *Config) (wazero.CompiledModule, error) {
+	original, err := r.CompileModule(ctx, module)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidModule, err)
+	}
+	_ = original.Close(ctx)
+
+	metered, err := meter(module, config)
+	if err != nil {
+		return nil, err
+	}
+	compiled, err := r.CompileModule(ctx, metered)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidModule, err)
+	}
+	return compiled, nil
+}
+
+func validateExports(compiled wazero.CompiledModule) error {
+	if _, ok := compiled.ExportedMemories()["memory"]; !ok {
+		return fmt.Errorf("%w: memory not exported", ErrInvalidModule)
+	}
+	defined := compiled.ExportedFunctions()
+	for name, sig := range exports {
+		def, ok := defined[name]
+		if !ok {
+			return fmt.Errorf("%w: function %q not exported", ErrInvalidModule, name)
+		}
+		if !slices.Equal(def.ParamTypes(), sig.params) || !slices.Equal(def.ResultTypes(), sig.results) {
+			return fmt.Errorf("%w: function %q has invalid signature", ErrInvalidModule, name)
+		}
+	}
+	return nil
+}
+
+// Close releases all resources of the WebAssembly runtime.
+func (e *Executor) Close(ctx context.Context) error {
+	return e.runtime.Close(ctx)
+}
+
+// InitChain resets the store and calls init function of the module to set up the genesis state.
+func (e *Executor) InitChain(ctx context.Context, genesisTime time.Time, initialHeight uint64, chainID string) (types.Hash, uint64, error) {
+	if initialHeight == 0 {
+		return types.Hash{}, 0, types.ErrZeroInitialHeight
+	}
+	if chainID == "" {
+		return types.Hash{}, 0, types.ErrEmptyChainID
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	genesis := make(map[string][]byte)
+	s := e.newSession(newOverlay(nil), genesis, false)
+	err := e.run(ctx, s, func(ctx context.Context, mod api.Module) error {
+		ptr, err := write(ctx, mod, []byte(chainID))
+		if err != nil {
+			return err
+		}
+		results, err := mod.ExportedFunction("init").Call(ctx, uint64(ptr), uint64(len(chainID)), initialHeight)
+		if err != nil {
+			return err
+		}
+		if code := api.DecodeU32(results[0]); code != 0 {
+			return fmt.Errorf("%w: code %d", ErrInitChainFailed, code)
+		}
+		return nil
+	})
+	if err != nil {
+		return types.Hash{}, 0, err
+	}
+
+	e.finalized = s.state.flatten(genesis)
+	e.finalRoot = stateRoot(e.finalized)
+	e.pending = make(map[pendingKey]*block)
+	e.initialized = true
+	e.finalHeight = initialHeight - 1
+	return e.finalRoot, e.config.MaxBytes, nil
+}
+
+// GetTxs returns transactions injected with InjectTx and not yet executed.
+func (e *Executor) GetTxs(context.Context) ([]types.Tx, error) {
+	return e.mempool.Reap(e.config.MaxBytes), nil
+}
+
+// InjectTx adds transaction to the mempool. Transactions rejected by the mempool (e.g. duplicates) are ignored.
+func (e *Executor) InjectTx(tx types.Tx) {
+	_ = e.mempool.Add(tx)
+}
+
+// ExecuteTxs runs execute function of the module for every transaction. Transactions are executed on top of
+// the pending block at blockHeight-1 with state root equal to prevStateRoot, or on top of the finalized
+// state if prevStateRoot is its root; once the chain is initialized, types.ErrBlockNotFound is returned
+// otherwise. Previously executed block at the same height on the same state root is replaced; blocks on
+// other state roots are kept as competing candidates.
+//
+// Every transaction has its own fuel budget. Writes of transactions that fail, trap or run out of fuel are
+// reverted; all transactions are removed from the mempool.
+func (e *Executor) ExecuteTxs(ctx context.Context, txs []types.Tx, blockHeight uint64, timestamp time.Time, prevStateRoot types.Hash) (types.Hash, uint64, error) {
+	if blockHeight == 0 {
+		return types.Hash{}, 0, types.ErrInvalidBlockHeight
+	}
+	if len(prevStateRoot) == 0 {
+		return types.Hash{}, 0, types.ErrEmptyStateRoot
+	}
+	for _, tx := range txs {
+		if len(tx) == 0 {
+			return types.Hash{}, 0, types.ErrEmptyTx
+		}
+		if uint64(len(tx)) > e.config.MaxBytes {
+			return types.Hash{}, 0, types.ErrTxTooLarge
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var parent *overlay
+	if prev := e.pendingWithRoot(blockHeight-1, prevStateRoot); prev != nil {
+		parent = prev.state
+	} else if e.initialized && !bytes.Equal(e.finalRoot, prevStateRoot) {
+		return types.Hash{}, 0, fmt.Errorf("%w: no block with state root %X", types.ErrBlockNotFound, prevStateRoot)
+	}
+	state := newOverlay(parent)
+	s := e.newSession(state, e.finalized, false)
+	err := e.run(ctx, s, func(ctx context.Context, mod api.Module) error {
+		execute := mod.ExportedFunction("execute")
+		for _, tx := range txs {
+			s.state = newOverlay(state)
+			setFuel(mod, e.config.FuelLimit)
+			ptr, err := write(ctx, mod, tx)
+			var results []uint64
+			if err == nil {
+				results, err = execute.Call(ctx, blockHeight, uint64(timestamp.Unix()), uint64(ptr), uint64(len(tx))) //nolint:gosec
+			}
+			if err != nil {
+				if isFatal(ctx, err) {
+					return err
+				}
+				// trapped transaction, including one out of fuel, is considered failed
+				continue
+			}
+			if api.DecodeU32(results[0]) == 0 {
+				state.merge(s.state)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return types.Hash{}, 0, err
+	}
+
+	root := stateRoot(state.flatten(e.finalized))
+	e.pendingSeq++
+	e.pending[pendingKey{height: blockHeight, prevRoot: string(prevStateRoot)}] = &block{root: root, state: state, seq: e.pendingSeq}
+	e.mempool.Update(blockHeight, txs)
+	return root, e.config.MaxBytes, nil
+}
+
+// SetFinal writes state of the block at given height, including pending blocks it descends from, to the
+// finalized state. If multiple blocks are pending at the height, the one descending from the finalized state
+// is finalized, or the most recently executed one if none does. Pending blocks not descending from the
+// finalized block are discarded. Finalizing already finalized height has no effect.
+func (e *Executor) SetFinal(ctx context.Context, blockHeight uint64) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.initialized && blockHeight <= e.finalHeight {
+		return nil
+	}
+	final := e.pendingAt(blockHeight)
+	if final == nil {
+		return types.ErrBlockNotFound
+	}
+
+	e.finalized = final.state.flatten(e.finalized)
+	e.finalRoot = final.root
+	e.finalHeight = blockHeight
+	for key, b := range e.pending {
+		if key.height <= blockHeight || !descends(b.state, final.state) {
+			delete(e.pending, key)
+		}
+	}
+	// detach only after all descendants were found, as pending blocks may share overlays
+	for _, b := range e.pending {
+		rebase(b.state, final.state)
+	}
+	return nil
+}
+
+// pendingWithRoot returns the pending block at given height with given state root. Caller must hold the lock.
+func (e *Executor) pendingWithRoot(height uint64, root types.Hash) *block {
+	for key, b := range e.pending {
+		if key.height == height && bytes.Equal(b.root, root) {
+			return b
+		}
+	}
+	return nil
+}
+
+// pendingAt returns the candidate block at given height: the one descending from the finalized state through
+// candidates at lower heights if any, the most recently executed one otherwise. Caller must hold the lock.
+func (e *Executor) pendingAt(height uint64) *block {
+	root := e.finalRoot
+	for h := e.finalHeight + 1; h <= height; h++ {
+		b, ok := e.pending[pendingKey{height: h, prevRoot: string(root)}]
+		if !ok {
+			break
+		}
+		if h == height {
+			return b
+		}
+		root = b.root
+	}
+
+	var latest *block
+	for key, b := range e.pending {
+		if key.height == height && (latest == nil || b.seq > latest.seq) {
+			latest = b
+		}
+	}
+	return latest
+}
+
+// descends reports whether ancestor is an ancestor of the overlay.
+func descends(o *overlay, ancestor *overlay) bool {
+	for s := o; s != nil; s = s.parent {
+		if s.parent == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+// rebase detaches overlay from ancestor, which was merged into the finalized state. Overlays detached
+// through a shared parent are left untouched.
+func rebase(o *overlay, ancestor *overlay) {
+	for s := o; s != nil; s = s.parent {
+		if s.parent == ancestor {
+			s.parent = nil
+			return
+		}
+	}
+}
+
+// Query runs query function of the module against the finalized state. The module can't modify the store.
+func (e *Executor) Query(ctx context.Context, req []byte) ([]byte, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var resp []byte
+	s := e.newSession(newOverlay(nil), e.finalized, true)
+	err := e.run(ctx, s, func(ctx context.Context, mod api.Module) error {
+		ptr, err := write(ctx, mod, req)
+		if err != nil {
+			return err
+		}
+		results, err := mod.ExportedFunction("query").Call(ctx, uint64(ptr), uint64(len(req)))
+		if err != nil {
+			return err
+		}
+		view, ok := mod.Memory().Read(uint32(results[0]>>32), uint32(results[0])) //nolint:gosec
+		if !ok {
+			return ErrMemoryAccess
+		}
+		resp = bytes.Clone(view)
+		return nil
+	})
+	return resp, err
+}
+
+// CheckHealth reports executor as ready once the chain was initialized.
+func (e *Executor) CheckHealth(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !e.initialized {
+		return types.ErrChainNotInitialized
+	}
+	return nil
+}
+
+func (e *Executor) newSession(state *overlay, finalized map[string][]byte, readOnly bool) *session {
+	return &session{state: state, finalized: finalized, readOnly: readOnly, config: e.config}
+}
+
+// run instantiates the module and calls fn with it. Errors caused by fuel exhaustion are unwrapped
+// from runtime errors. Execution timeout only protects the host; call exceeding it fails without any effect.
+func (e *Executor) run(ctx context.Context, s *session, fn func(context.Context, api.Module) error) error {
+	if e.config.ExecutionTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.config.ExecutionTimeout)
+		defer cancel()
+	}
+	ctx = withSession(ctx, s)
+
+	mod, err := e.runtime.InstantiateModule(ctx, e.compiled, wazero.NewModuleConfig().WithName(""))
+	if err != nil {
+		return fmt.Errorf("failed to instantiate module: %w", err)
+	}
+	defer func() {
+		_ = mod.Close(ctx)
+	}()
+
+	err = fn(ctx, mod)
+	switch {
+	case err == nil:
+		return nil
+	case ctx.Err() != nil:
+		return ctx.Err()
+	case outOfFuel(mod):
+		return fmt.Errorf("%w: limit %d", ErrOutOfFuel, e.config.FuelLimit)
+	}
+	return err
+}
+
+// isFatal returns true if error aborts the whole call, rather than just the current transaction.
+func isFatal(ctx context.Context, err error) bool {
+	var exitErr *sys.ExitError
+	return ctx.Err() != nil || errors.As(err, &exitErr)
+}
+
+// write copies data to memory allocated by the module.
+func write(ctx context.Context, mod api.Module, data []byte) (uint32, error) {
+	results, err := mod.ExportedFunction("alloc").Call(ctx, uint64(len(data)))
+	if err != nil {
+		return 0, err
+	}
+	ptr := api.DecodeU32(results[0])
+	if !mod.Memory().Write(ptr, data) {
+		return 0, fmt.Errorf("%w: alloc returned invalid pointer", ErrMemoryAccess)
+	}
+	return ptr, nil
+}
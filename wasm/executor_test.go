@@ -0,0 +1,233 @@
+package wasm_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/rollkit/go-execution/test"
+	"github.com/rollkit/go-execution/types"
+	"github.com/rollkit/go-execution/wasm"
+)
+
+// newExecutor creates executor running testdata/kv.wasm module; see testdata/kv.wat for its behavior.
+func newExecutor(t *testing.T, config *wasm.Config) *wasm.Executor {
+	t.Helper()
+	module, err := os.ReadFile("testdata/kv.wasm")
+	require.NoError(t, err)
+
+	exec, err := wasm.NewExecutor(context.Background(), module, config)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, exec.Close(context.Background()))
+	})
+	return exec
+}
+
+type ExecutorTestSuite struct {
+	test.ExecutorSuite
+}
+
+func (s *ExecutorTestSuite) SetupTest() {
+	exec := newExecutor(s.T(), nil)
+	s.Exec = exec
+	s.TxInjector = exec
+}
+
+func TestExecutorSuite(t *testing.T) {
+	suite.Run(t, new(ExecutorTestSuite))
+}
+
+func query(t *testing.T, exec *wasm.Executor, key string) string {
+	t.Helper()
+	value, err := exec.Query(context.Background(), []byte(key))
+	require.NoError(t, err)
+	return string(value)
+}
+
+func TestExecuteAndFinalize(t *testing.T) {
+	exec := newExecutor(t, nil)
+	ctx := context.Background()
+
+	genesisRoot, _, err := exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+	assert.Equal(t, "test-chain", query(t, exec, "chain_id"))
+
+	txs := []types.Tx{
+		types.Tx("a=1"),
+		types.Tx("invalid"),
+		types.Tx("b=2!"),
+		types.Tx("c=3"),
+	}
+	root1, _, err := exec.ExecuteTxs(ctx, txs, 1, time.Now(), genesisRoot)
+	require.NoError(t, err)
+	assert.NotEqual(t, genesisRoot, root1)
+
+	// pending state is not visible to queries
+	assert.Equal(t, "", query(t, exec, "a"))
+
+	root2, _, err := exec.ExecuteTxs(ctx, []types.Tx{types.Tx("a=4")}, 2, time.Now(), root1)
+	require.NoError(t, err)
+
+	require.NoError(t, exec.SetFinal(ctx, 1))
+	assert.Equal(t, "1", query(t, exec, "a"))
+	assert.Equal(t, "", query(t, exec, "b"), "writes of failed transaction must be reverted")
+	assert.Equal(t, "3", query(t, exec, "c"))
+
+	require.NoError(t, exec.SetFinal(ctx, 2))
+	assert.Equal(t, "4", query(t, exec, "a"))
+	assert.Equal(t, "3", query(t, exec, "c"))
+
+	// repeated finalization has no effect
+	require.NoError(t, exec.SetFinal(ctx, 2))
+	assert.Equal(t, "4", query(t, exec, "a"))
+
+	// block executed on top of the finalized state
+	root3, _, err := exec.ExecuteTxs(ctx, nil, 3, time.Now(), root2)
+	require.NoError(t, err)
+	assert.Equal(t, root2, root3)
+}
+
+func TestDeterministicStateRoot(t *testing.T) {
+	ctx := context.Background()
+	genesisTime := time.Now().UTC()
+
+	roots := make([]types.Hash, 2)
+	for i := range roots {
+		exec := newExecutor(t, nil)
+		root, _, err := exec.InitChain(ctx, genesisTime, 1, "test-chain")
+		require.NoError(t, err)
+		roots[i], _, err = exec.ExecuteTxs(ctx, []types.Tx{types.Tx("x=1"), types.Tx("y=2")}, 1, genesisTime, root)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, roots[0], roots[1])
+
+	// state root depends only on the store contents, not on order of writes
+	exec := newExecutor(t, nil)
+	root, _, err := exec.InitChain(ctx, genesisTime, 1, "test-chain")
+	require.NoError(t, err)
+	reordered, _, err := exec.ExecuteTxs(ctx, []types.Tx{types.Tx("y=2"), types.Tx("x=1")}, 1, genesisTime, root)
+	require.NoError(t, err)
+	assert.Equal(t, roots[0], reordered)
+}
+
+func TestFuelLimit(t *testing.T) {
+	config := wasm.DefaultConfig()
+	config.FuelLimit = 10_000
+	// fuel metering alone must stop endless loops
+	config.ExecutionTimeout = 0
+	exec := newExecutor(t, config)
+	ctx := context.Background()
+
+	root, _, err := exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+
+	// transaction out of fuel fails, other transactions have their own budget
+	exec.InjectTx(types.Tx("#burn"))
+	txs, err := exec.GetTxs(ctx)
+	require.NoError(t, err)
+	txs = append(txs, types.Tx("a=1"), types.Tx("#burn2"), types.Tx("b=2"))
+	root1, _, err := exec.ExecuteTxs(ctx, txs, 1, time.Now(), root)
+	require.NoError(t, err)
+
+	// failed transactions have no effect on the state
+	other := newExecutor(t, config)
+	_, _, err = other.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+	expected, _, err := other.ExecuteTxs(ctx, []types.Tx{types.Tx("a=1"), types.Tx("b=2")}, 1, time.Now(), root)
+	require.NoError(t, err)
+	assert.Equal(t, expected, root1)
+
+	// failed transaction is removed from the mempool
+	txs, err = exec.GetTxs(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, txs)
+
+	require.NoError(t, exec.SetFinal(ctx, 1))
+	assert.Equal(t, "1", query(t, exec, "a"))
+	assert.Equal(t, "2", query(t, exec, "b"))
+}
+
+func TestFuelUsage(t *testing.T) {
+	config := wasm.DefaultConfig()
+	config.CallCost = 64
+	config.LoopCost = 32
+	config.WriteCost = 20
+	config.ByteCost = 1
+	config.ExecutionTimeout = 0
+	// "abcd=1" calls alloc and execute, iterates the scan loop 5 times and writes 5 bytes
+	const used = 2*64 + 5*32 + 20 + 5
+
+	for limit, expected := range map[uint64]string{used: "1", used - 1: ""} {
+		config.FuelLimit = limit
+		exec := newExecutor(t, config)
+		ctx := context.Background()
+
+		root, _, err := exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+		require.NoError(t, err)
+		_, _, err = exec.ExecuteTxs(ctx, []types.Tx{types.Tx("abcd=1")}, 1, time.Now(), root)
+		require.NoError(t, err)
+		require.NoError(t, exec.SetFinal(ctx, 1))
+		assert.Equal(t, expected, query(t, exec, "abcd"), "fuel limit %d", limit)
+	}
+}
+
+func TestInvalidModule(t *testing.T) {
+	_, err := wasm.NewExecutor(context.Background(), []byte("not a module"), nil)
+	assert.ErrorIs(t, err, wasm.ErrInvalidModule)
+
+	// valid module without any exports
+	empty := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	_, err = wasm.NewExecutor(context.Background(), empty, nil)
+	assert.ErrorIs(t, err, wasm.ErrInvalidModule)
+}
+
+func TestUnknownPrevStateRoot(t *testing.T) {
+	exec := newExecutor(t, nil)
+	ctx := context.Background()
+
+	genesisRoot, _, err := exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+	root1, _, err := exec.ExecuteTxs(ctx, []types.Tx{types.Tx("a=1")}, 1, time.Now(), genesisRoot)
+	require.NoError(t, err)
+
+	_, _, err = exec.ExecuteTxs(ctx, []types.Tx{types.Tx("a=2")}, 1, time.Now(), types.Hash("stale"))
+	assert.ErrorIs(t, err, types.ErrBlockNotFound)
+	// pending block doesn't match parent at other heights
+	_, _, err = exec.ExecuteTxs(ctx, []types.Tx{types.Tx("a=2")}, 3, time.Now(), root1)
+	assert.ErrorIs(t, err, types.ErrBlockNotFound)
+
+	_, _, err = exec.ExecuteTxs(ctx, []types.Tx{types.Tx("a=2")}, 2, time.Now(), root1)
+	assert.NoError(t, err)
+}
+
+func TestCompetingBlocks(t *testing.T) {
+	exec := newExecutor(t, nil)
+	ctx := context.Background()
+
+	genesisRoot, _, err := exec.InitChain(ctx, time.Now().UTC(), 1, "test-chain")
+	require.NoError(t, err)
+	root1, _, err := exec.ExecuteTxs(ctx, []types.Tx{types.Tx("a=1")}, 1, time.Now(), genesisRoot)
+	require.NoError(t, err)
+	root2, _, err := exec.ExecuteTxs(ctx, []types.Tx{types.Tx("b=1")}, 2, time.Now(), root1)
+	require.NoError(t, err)
+
+	// block at height 1 is replaced, competing block at height 2 doesn't replace the one on the previous root
+	root1b, _, err := exec.ExecuteTxs(ctx, []types.Tx{types.Tx("a=2")}, 1, time.Now(), genesisRoot)
+	require.NoError(t, err)
+	_, _, err = exec.ExecuteTxs(ctx, []types.Tx{types.Tx("b=2")}, 2, time.Now(), root1b)
+	require.NoError(t, err)
+	_, _, err = exec.ExecuteTxs(ctx, []types.Tx{types.Tx("c=1")}, 3, time.Now(), root2)
+	require.NoError(t, err)
+
+	// block descending from the finalized state is finalized
+	require.NoError(t, exec.SetFinal(ctx, 2))
+	assert.Equal(t, "2", query(t, exec, "a"))
+	assert.Equal(t, "2", query(t, exec, "b"))
+	assert.ErrorIs(t, exec.SetFinal(ctx, 3), types.ErrBlockNotFound, "blocks of the other branch are discarded")
+}
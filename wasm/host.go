@@ -0,0 +1,131 @@
+package wasm
+
+import (
+	"context"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+)
+
+// hostModule is the name of the module providing host functions to the executed module.
+const hostModule = "env"
+
+// session holds state of a single call into the module.
+type session struct {
+	state     *overlay
+	finalized map[string][]byte
+	readOnly  bool
+
+	config *Config
+}
+
+type sessionKey struct{}
+
+func withSession(ctx context.Context, s *session) context.Context {
+	return context.WithValue(ctx, sessionKey{}, s)
+}
+
+func sessionFrom(ctx context.Context) *session {
+	s, _ := ctx.Value(sessionKey{}).(*session)
+	return s
+}
+
+// fuel returns the global of the metered module holding fuel remaining in the current call.
+func fuel(mod api.Module) api.MutableGlobal {
+	return mod.ExportedGlobal(fuelGlobal).(api.MutableGlobal)
+}
+
+// setFuel sets fuel remaining in the current call of the module.
+func setFuel(mod api.Module, amount uint64) {
+	fuel(mod).Set(api.EncodeI64(clamp(amount)))
+}
+
+// outOfFuel reports whether the module ran out of fuel.
+func outOfFuel(mod api.Module) bool {
+	return int64(fuel(mod).Get()) < 0 //nolint:gosec
+}
+
+// consume charges fuel used by host functions, aborting the execution if the module runs out of fuel.
+func consume(mod api.Module, amount uint64) {
+	g := fuel(mod)
+	remaining := int64(g.Get()) //nolint:gosec
+	if remaining < 0 || amount > uint64(remaining) {
+		g.Set(api.EncodeI64(-1))
+		panic(ErrOutOfFuel)
+	}
+	g.Set(uint64(remaining) - amount)
+}
+
+// instantiateHost registers host functions available to the module:
+//
+//	kv_get(key_ptr, key_len, value_ptr, value_cap i32) i32
+//	kv_set(key_ptr, key_len, value_ptr, value_len i32)
+//	kv_delete(key_ptr, key_len i32)
+//
+// kv_get copies at most value_cap bytes of the value to value_ptr and returns full length of the value,
+// or -1 if the key doesn't exist.
+func instantiateHost(ctx context.Context, r wazero.Runtime) error {
+	i32 := api.ValueTypeI32
+	_, err := r.NewHostModuleBuilder(hostModule).
+		NewFunctionBuilder().
+		WithGoModuleFunction(api.GoModuleFunc(kvGet), []api.ValueType{i32, i32, i32, i32}, []api.ValueType{i32}).
+		Export("kv_get").
+		NewFunctionBuilder().
+		WithGoModuleFunction(api.GoModuleFunc(kvSet), []api.ValueType{i32, i32, i32, i32}, nil).
+		Export("kv_set").
+		NewFunctionBuilder().
+		WithGoModuleFunction(api.GoModuleFunc(kvDelete), []api.ValueType{i32, i32}, nil).
+		Export("kv_delete").
+		Instantiate(ctx)
+	return err
+}
+
+func kvGet(ctx context.Context, mod api.Module, stack []uint64) {
+	s := sessionFrom(ctx)
+	key := read(mod, stack[0], stack[1])
+	consume(mod, s.config.ReadCost+s.config.ByteCost*uint64(len(key)))
+
+	value, ok := s.state.get(s.finalized, string(key))
+	if !ok {
+		stack[0] = api.EncodeI32(-1)
+		return
+	}
+	consume(mod, s.config.ByteCost*uint64(len(value)))
+	n := min(uint32(len(value)), api.DecodeU32(stack[3])) //nolint:gosec
+	if !mod.Memory().Write(api.DecodeU32(stack[2]), value[:n]) {
+		panic(ErrMemoryAccess)
+	}
+	stack[0] = api.EncodeI32(int32(len(value))) //nolint:gosec
+}
+
+func kvSet(ctx context.Context, mod api.Module, stack []uint64) {
+	s := sessionFrom(ctx)
+	if s.readOnly {
+		panic(ErrReadOnly)
+	}
+	key := read(mod, stack[0], stack[1])
+	value := read(mod, stack[2], stack[3])
+	consume(mod, s.config.WriteCost+s.config.ByteCost*uint64(len(key)+len(value)))
+	s.state.set(string(key), value)
+}
+
+func kvDelete(ctx context.Context, mod api.Module, stack []uint64) {
+	s := sessionFrom(ctx)
+	if s.readOnly {
+		panic(ErrReadOnly)
+	}
+	key := read(mod, stack[0], stack[1])
+	consume(mod, s.config.WriteCost+s.config.ByteCost*uint64(len(key)))
+	s.state.delete(string(key))
+}
+
+// read returns a copy of module memory.
+func read(mod api.Module, ptr, length uint64) []byte {
+	view, ok := mod.Memory().Read(api.DecodeU32(ptr), api.DecodeU32(length))
+	if !ok {
+		panic(ErrMemoryAccess)
+	}
+	data := make([]byte, len(view))
+	copy(data, view)
+	return data
+}
@@ -0,0 +1,374 @@
+package wasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// fuelGlobal is the name of the global exported by metered modules, holding fuel remaining in the current call.
+const fuelGlobal = "__fuel"
+
+const (
+	sectionGlobal = 6
+	sectionExport = 7
+	sectionCode   = 10
+
+	opLoop = 0x03
+)
+
+// sectionOrder is the required order of non-custom sections in a module.
+var sectionOrder = map[byte]int{1: 1, 2: 2, 3: 3, 4: 4, 5: 5, 13: 6, 6: 7, 7: 8, 8: 9, 9: 10, 12: 11, 10: 12, 11: 13}
+
+var wasmHeader = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+type section struct {
+	id   byte
+	data []byte
+}
+
+// meter instruments the module, so fuel is charged deterministically by the module code itself: CallCost at
+// entry of every function and LoopCost at every iteration of every loop. Remaining fuel is kept in a mutable
+// i64 global, initialized to FuelLimit and exported as fuelGlobal. Instrumented code traps with unreachable
+// as soon as the fuel becomes negative.
+//
+// The module must be validated before it's instrumented, as the global is appended to the globals of the module
+// and invalid code could refer to it.
+func meter(module []byte, config *Config) ([]byte, error) {
+	if !bytes.HasPrefix(module, wasmHeader) {
+		return nil, fmt.Errorf("%w: invalid header", ErrInvalidModule)
+	}
+	var sections []section
+	r := &reader{data: module, pos: len(wasmHeader)}
+	for !r.done() {
+		id := r.byte()
+		size := r.leb()
+		data := r.bytes(size)
+		if r.err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidModule, r.err)
+		}
+		sections = append(sections, section{id: id, data: data})
+	}
+
+	global, err := globalIndex(sections)
+	if err != nil {
+		return nil, err
+	}
+
+	init := []byte{0x7e, 0x01, 0x42} // mutable i64 initialized with i64.const
+	init = appendSigned(init, clamp(config.FuelLimit))
+	init = append(init, 0x0b)
+	sections = appendEntry(sections, sectionGlobal, init)
+
+	export := binary.AppendUvarint(nil, uint64(len(fuelGlobal)))
+	export = append(export, fuelGlobal...)
+	export = append(export, 0x03) // global
+	export = binary.AppendUvarint(export, uint64(global))
+	sections = appendEntry(sections, sectionExport, export)
+
+	for i, s := range sections {
+		if s.id != sectionCode {
+			continue
+		}
+		code, err := meterCode(s.data, global, config)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidModule, err)
+		}
+		sections[i].data = code
+	}
+
+	out := slices.Clone(wasmHeader)
+	for _, s := range sections {
+		out = append(out, s.id)
+		out = binary.AppendUvarint(out, uint64(len(s.data)))
+		out = append(out, s.data...)
+	}
+	return out, nil
+}
+
+// globalIndex returns index of the global appended to the module: imported globals are followed by the globals
+// defined by the module.
+func globalIndex(sections []section) (uint32, error) {
+	var count uint64
+	for _, s := range sections {
+		r := &reader{data: s.data}
+		switch s.id {
+		case 2: // imports
+			n := r.leb()
+			for i := uint64(0); i < n && r.err == nil; i++ {
+				r.bytes(r.leb()) // module
+				r.bytes(r.leb()) // name
+				switch kind := r.byte(); kind {
+				case 0x00: // func
+					r.leb()
+				case 0x01: // table
+					r.byte()
+					r.limits()
+				case 0x02: // memory
+					r.limits()
+				case 0x03: // global
+					r.byte()
+					r.byte()
+					count++
+				case 0x04: // tag
+					r.byte()
+					r.leb()
+				default:
+					r.fail("unknown import kind 0x%x", kind)
+				}
+			}
+		case sectionGlobal:
+			count += r.leb()
+		}
+		if r.err != nil {
+			return 0, fmt.Errorf("%w: %w", ErrInvalidModule, r.err)
+		}
+	}
+	if count >= math.MaxUint32 {
+		return 0, fmt.Errorf("%w: too many globals", ErrInvalidModule)
+	}
+	return uint32(count), nil
+}
+
+// appendEntry appends entry to the vector of the section with given id, creating the section if necessary.
+func appendEntry(sections []section, id byte, entry []byte) []section {
+	for i, s := range sections {
+		if s.id == id {
+			r := &reader{data: s.data}
+			count := r.leb()
+			data := binary.AppendUvarint(nil, count+1)
+			data = append(data, s.data[r.pos:]...)
+			sections[i].data = append(data, entry...)
+			return sections
+		}
+	}
+	data := append([]byte{0x01}, entry...)
+	pos := len(sections)
+	for i, s := range sections {
+		if order, ok := sectionOrder[s.id]; ok && order > sectionOrder[id] {
+			pos = i
+			break
+		}
+	}
+	return slices.Insert(sections, pos, section{id: id, data: data})
+}
+
+// meterCode instruments all function bodies of the code section.
+func meterCode(code []byte, global uint32, config *Config) ([]byte, error) {
+	r := &reader{data: code}
+	n := r.leb()
+	out := binary.AppendUvarint(nil, n)
+	for i := uint64(0); i < n && r.err == nil; i++ {
+		body := r.bytes(r.leb())
+		if r.err != nil {
+			break
+		}
+		metered, err := meterBody(body, global, config)
+		if err != nil {
+			return nil, fmt.Errorf("function %d: %w", i, err)
+		}
+		out = binary.AppendUvarint(out, uint64(len(metered)))
+		out = append(out, metered...)
+	}
+	return out, r.err
+}
+
+// meterBody charges CallCost at entry of the function and LoopCost at start of every loop iteration.
+func meterBody(body []byte, global uint32, config *Config) ([]byte, error) {
+	r := &reader{data: body}
+	locals := r.leb()
+	for i := uint64(0); i < locals && r.err == nil; i++ {
+		r.leb()
+		r.byte()
+	}
+	out := slices.Clone(body[:r.pos])
+	out = appendCharge(out, global, config.CallCost)
+	for !r.done() && r.err == nil {
+		start := r.pos
+		op := r.byte()
+		r.skipImmediates(op)
+		out = append(out, body[start:r.pos]...)
+		if op == opLoop {
+			out = appendCharge(out, global, config.LoopCost)
+		}
+	}
+	return out, r.err
+}
+
+// appendCharge appends code subtracting cost from the fuel global and trapping if the fuel becomes negative:
+//
+//	(global.set $fuel (i64.sub (global.get $fuel) (i64.const cost)))
+//	(if (i64.lt_s (global.get $fuel) (i64.const 0)) (then unreachable))
+func appendCharge(out []byte, global uint32, cost uint64) []byte {
+	if cost == 0 {
+		return out
+	}
+	out = append(out, 0x23)
+	out = binary.AppendUvarint(out, uint64(global))
+	out = append(out, 0x42)
+	out = appendSigned(out, clamp(cost))
+	out = append(out, 0x7d, 0x24)
+	out = binary.AppendUvarint(out, uint64(global))
+	out = append(out, 0x23)
+	out = binary.AppendUvarint(out, uint64(global))
+	return append(out, 0x42, 0x00, 0x53, 0x04, 0x40, 0x00, 0x0b)
+}
+
+// appendSigned appends v encoded as signed LEB128 number, the encoding of i64.const immediates.
+func appendSigned(out []byte, v int64) []byte {
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && b&0x40 == 0) || (v == -1 && b&0x40 != 0) {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
+func clamp(v uint64) int64 {
+	return int64(min(v, math.MaxInt64)) //nolint:gosec
+}
+
+// reader decodes WebAssembly binary format. The first error is kept in err; subsequent reads return zero values.
+type reader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *reader) done() bool {
+	return r.pos >= len(r.data)
+}
+
+func (r *reader) fail(format string, args ...any) {
+	if r.err == nil {
+		r.err = fmt.Errorf(format, args...)
+	}
+}
+
+func (r *reader) byte() byte {
+	if r.err != nil {
+		return 0
+	}
+	if r.done() {
+		r.fail("unexpected end at offset %d", r.pos)
+		return 0
+	}
+	b := r.data[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *reader) bytes(n uint64) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n > uint64(len(r.data)-r.pos) {
+		r.fail("unexpected end at offset %d", r.pos)
+		return nil
+	}
+	b := r.data[r.pos : r.pos+int(n)]
+	r.pos += int(n)
+	return b
+}
+
+// leb reads unsigned LEB128 number. Signed numbers are read as well, when only their length matters.
+func (r *reader) leb() uint64 {
+	var v uint64
+	for shift := 0; shift < 70; shift += 7 {
+		b := r.byte()
+		v |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return v
+		}
+	}
+	r.fail("invalid LEB128 number at offset %d", r.pos)
+	return 0
+}
+
+func (r *reader) limits() {
+	if flags := r.byte(); flags&0x01 != 0 {
+		r.leb()
+	}
+	r.leb()
+}
+
+func (r *reader) memarg() {
+	if align := r.leb(); align&0x40 != 0 {
+		r.leb() // memory index
+	}
+	r.leb()
+}
+
+// skipImmediates skips immediate arguments of the instruction.
+func (r *reader) skipImmediates(op byte) {
+	switch {
+	case op == 0x02 || op == opLoop || op == 0x04: // block, loop, if
+		r.leb()
+	case op == 0x0c || op == 0x0d || op == 0x10 || op == 0xd2: // br, br_if, call, ref.func
+		r.leb()
+	case op >= 0x20 && op <= 0x26: // local.*, global.*, table.get, table.set
+		r.leb()
+	case op == 0x0e: // br_table
+		n := r.leb()
+		for i := uint64(0); i <= n && r.err == nil; i++ {
+			r.leb()
+		}
+	case op == 0x11: // call_indirect
+		r.leb()
+		r.leb()
+	case op == 0x1c: // select with types
+		r.bytes(r.leb())
+	case op >= 0x28 && op <= 0x3e: // loads and stores
+		r.memarg()
+	case op == 0x3f || op == 0x40: // memory.size, memory.grow
+		r.leb()
+	case op == 0x41 || op == 0x42: // i32.const, i64.const
+		r.leb()
+	case op == 0x43: // f32.const
+		r.bytes(4)
+	case op == 0x44: // f64.const
+		r.bytes(8)
+	case op == 0xd0: // ref.null
+		r.byte()
+	case op == 0xfc:
+		r.skipMisc(r.leb())
+	case op == 0xfd:
+		r.skipVector(r.leb())
+	case op <= 0x01, op == 0x05, op == 0x0b, op == 0x0f, op == 0x1a, op == 0x1b, op >= 0x45 && op <= 0xc4, op == 0xd1:
+	default:
+		r.fail("unsupported opcode 0x%x at offset %d", op, r.pos-1)
+	}
+}
+
+// skipMisc skips immediates of instructions with 0xfc prefix.
+func (r *reader) skipMisc(op uint64) {
+	switch {
+	case op <= 7: // saturating truncation
+	case op == 9 || op == 11 || op == 13 || op == 15 || op == 16 || op == 17:
+		r.leb()
+	case op == 8 || op == 10 || op == 12 || op == 14:
+		r.leb()
+		r.leb()
+	default:
+		r.fail("unsupported opcode 0xfc %d at offset %d", op, r.pos)
+	}
+}
+
+// skipVector skips immediates of instructions with 0xfd prefix.
+func (r *reader) skipVector(op uint64) {
+	switch {
+	case op <= 11 || op == 92 || op == 93: // loads and stores
+		r.memarg()
+	case op == 12 || op == 13: // v128.const, i8x16.shuffle
+		r.bytes(16)
+	case op >= 21 && op <= 34: // lane extraction and replacement
+		r.byte()
+	case op >= 84 && op <= 91: // lane loads and stores
+		r.memarg()
+		r.byte()
+	}
+}
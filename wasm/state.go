@@ -0,0 +1,92 @@
+package wasm
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+
+	"github.com/rollkit/go-execution/types"
+)
+
+// overlay is a set of writes on top of parent overlay, or on top of the finalized state if parent is nil.
+type overlay struct {
+	parent *overlay
+	// writes maps keys to new values; nil value means that the key was deleted
+	writes map[string][]byte
+}
+
+func newOverlay(parent *overlay) *overlay {
+	return &overlay{parent: parent, writes: make(map[string][]byte)}
+}
+
+func (o *overlay) get(finalized map[string][]byte, key string) ([]byte, bool) {
+	for s := o; s != nil; s = s.parent {
+		if value, ok := s.writes[key]; ok {
+			return value, value != nil
+		}
+	}
+	value, ok := finalized[key]
+	return value, ok
+}
+
+func (o *overlay) set(key string, value []byte) {
+	if value == nil {
+		value = []byte{}
+	}
+	o.writes[key] = value
+}
+
+func (o *overlay) delete(key string) {
+	o.writes[key] = nil
+}
+
+// merge applies writes of child overlay.
+func (o *overlay) merge(child *overlay) {
+	for key, value := range child.writes {
+		o.writes[key] = value
+	}
+}
+
+// flatten returns the full state seen by overlay.
+func (o *overlay) flatten(finalized map[string][]byte) map[string][]byte {
+	var chain []*overlay
+	for s := o; s != nil; s = s.parent {
+		chain = append(chain, s)
+	}
+	state := make(map[string][]byte, len(finalized))
+	for key, value := range finalized {
+		state[key] = value
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		for key, value := range chain[i].writes {
+			if value == nil {
+				delete(state, key)
+			} else {
+				state[key] = value
+			}
+		}
+	}
+	return state
+}
+
+// stateRoot returns deterministic commitment to the state: SHA-256 of all key-value pairs, ordered by key,
+// each encoded as length-prefixed key followed by length-prefixed value.
+func stateRoot(state map[string][]byte) types.Hash {
+	keys := make([]string, 0, len(state))
+	for key := range state {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	h := sha256.New()
+	var length [binary.MaxVarintLen64]byte
+	for _, key := range keys {
+		n := binary.PutUvarint(length[:], uint64(len(key)))
+		h.Write(length[:n])
+		h.Write([]byte(key))
+		n = binary.PutUvarint(length[:], uint64(len(state[key])))
+		h.Write(length[:n])
+		h.Write(state[key])
+	}
+	return h.Sum(nil)
+}
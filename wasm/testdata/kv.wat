@@ -0,0 +1,46 @@
+;; Key-value store module used in tests. Source of kv.wasm.
+;;
+;; Transactions have the form "key=value" and set the key to the value. Transactions without "=" fail
+;; without any effect. Transactions with value ending with "!" set the key and then fail, so their
+;; writes must be reverted. Transactions starting with "#" loop endlessly without calling any function,
+;; until they run out of fuel.
+(module
+  (import "env" "kv_get" (func $kv_get (param i32 i32 i32 i32) (result i32)))
+  (import "env" "kv_set" (func $kv_set (param i32 i32 i32 i32)))
+  (memory (export "memory") 2)
+  (data (i32.const 16) "chain_id")
+
+  (func $alloc (export "alloc") (param $size i32) (result i32)
+    i32.const 8192)
+
+  (func $init (export "init") (param $ptr i32) (param $len i32) (param $height i64) (result i32)
+    (call $kv_set (i32.const 16) (i32.const 8) (local.get $ptr) (local.get $len))
+    i32.const 0)
+
+  (func $execute (export "execute") (param $height i64) (param $time i64) (param $ptr i32) (param $len i32) (result i32)
+    (local $i i32)
+    (if (i32.eq (i32.load8_u (local.get $ptr)) (i32.const 35))
+      (then (loop $burn (br $burn))))
+    (block $found
+      (loop $scan
+        (if (i32.ge_u (local.get $i) (local.get $len))
+          (then (return (i32.const 1))))
+        (br_if $found (i32.eq (i32.load8_u (i32.add (local.get $ptr) (local.get $i))) (i32.const 61)))
+        (local.set $i (i32.add (local.get $i) (i32.const 1)))
+        (br $scan)))
+    (call $kv_set
+      (local.get $ptr) (local.get $i)
+      (i32.add (i32.add (local.get $ptr) (local.get $i)) (i32.const 1))
+      (i32.sub (i32.sub (local.get $len) (local.get $i)) (i32.const 1)))
+    (if (i32.eq (i32.load8_u (i32.sub (i32.add (local.get $ptr) (local.get $len)) (i32.const 1))) (i32.const 33))
+      (then (return (i32.const 2))))
+    i32.const 0)
+
+  (func $query (export "query") (param $ptr i32) (param $len i32) (result i64)
+    (local $n i32)
+    (local.set $n (call $kv_get (local.get $ptr) (local.get $len) (i32.const 4096) (i32.const 4096)))
+    (if (i32.lt_s (local.get $n) (i32.const 0))
+      (then (local.set $n (i32.const 0))))
+    (i64.or (i64.shl (i64.const 4096) (i64.const 32)) (i64.extend_i32_u (local.get $n))))
+
+  (func $nop))